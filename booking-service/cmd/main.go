@@ -1,13 +1,16 @@
 package main
 
 import (
+	"booking-service/internal/auth"
 	"booking-service/internal/config"
+	"booking-service/internal/consumers"
 	"booking-service/internal/infrastructure"
 	"booking-service/internal/models"
 	"booking-service/internal/repository"
 	"booking-service/internal/services"
 	"booking-service/internal/transport"
 	"booking-service/internal/workers"
+	"context"
 	"os"
 
 	"github.com/gin-gonic/gin"
@@ -17,6 +20,11 @@ func main() {
 	logger := config.InitLogger()
 	logger.Info("Starting booking-service")
 
+	if err := auth.InitGuestTokenSecret(); err != nil {
+		logger.Error("Guest token secret is missing", "error", err)
+		os.Exit(1)
+	}
+
 	db := config.Connect()
 
 	router := gin.Default()
@@ -28,7 +36,7 @@ func main() {
 
 	logger.Info("Database connected successfully")
 
-	if err := db.AutoMigrate(&models.Booking{}, &models.BookedSeat{}); err != nil {
+	if err := db.AutoMigrate(&models.Booking{}, &models.BookedSeat{}, &models.Product{}, &models.BookingItem{}, &models.Voucher{}, &models.VoucherTransaction{}); err != nil {
 		logger.Error("Failed to migrate database", "error", err)
 		os.Exit(1)
 	}
@@ -40,12 +48,23 @@ func main() {
 
 	bookingRepo := repository.NewBookingRepository(db)
 	bookingSeatRepo := repository.NewBookingSeatRepository(db)
-	bookingService := services.NewBookingService(bookingRepo, bookingSeatRepo, db)
+	bookingItemRepo := repository.NewBookingItemRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	voucherRepo := repository.NewVoucherRepository(db)
+	reportRepo := repository.NewReportRepository(db)
+	bookingService := services.NewBookingService(bookingRepo, bookingSeatRepo, bookingItemRepo, productRepo, voucherRepo, db)
+	reportService := services.NewReportService(reportRepo)
+	productService := services.NewProductService(productRepo)
+	voucherService := services.NewVoucherService(voucherRepo, db)
+	reminderService := services.NewReminderService(bookingRepo)
 
 	go workers.StartExpiredBookingsWorker(bookingService)
 	go workers.StartEndedSessionsWorker(bookingService)
+	go workers.StartRemindersWorker(reminderService)
+	go consumers.StartSessionEventsConsumer(context.Background(), bookingService)
+	go consumers.StartUserCreatedConsumer(context.Background(), bookingService)
 
-	transport.RegisterRoutes(router, bookingService)
+	transport.RegisterRoutes(router, bookingService, reportService, productService, voucherService)
 
 	port := os.Getenv("PORT")
 	if port == "" {
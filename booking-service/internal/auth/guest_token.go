@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"booking-service/internal/constants"
+	"booking-service/internal/models"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var guestTokenSecret []byte
+
+// InitGuestTokenSecret loads the key guest tokens are signed with. A missing
+// key would let anyone forge tokens, so the service must not start without it.
+func InitGuestTokenSecret() error {
+	secret := os.Getenv("GUEST_TOKEN_SECRET")
+	if secret == "" {
+		return errors.New("GUEST_TOKEN_SECRET is not set")
+	}
+	guestTokenSecret = []byte(secret)
+	return nil
+}
+
+// GuestTokenFor issues the token for a guest booking. It stays valid until
+// GuestTokenValidity after the session ends.
+func GuestTokenFor(booking *models.Booking) string {
+	return GenerateGuestToken(booking.ID, booking.GuestEmail, booking.SessionEndTime.Add(constants.GuestTokenValidity))
+}
+
+// GenerateGuestToken signs the booking, the guest email and the expiry. The
+// token has the form "<unix expiry>.<hex mac>".
+func GenerateGuestToken(bookingID uint, email string, expiresAt time.Time) string {
+	expires := strconv.FormatInt(expiresAt.Unix(), 10)
+	return expires + "." + guestTokenMAC(bookingID, email, expires)
+}
+
+func VerifyGuestToken(bookingID uint, email, token string) bool {
+	if token == "" || email == "" {
+		return false
+	}
+
+	expires, mac, found := strings.Cut(token, ".")
+	if !found {
+		return false
+	}
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() >= expiresAt {
+		return false
+	}
+
+	expected := guestTokenMAC(bookingID, email, expires)
+	return hmac.Equal([]byte(expected), []byte(mac))
+}
+
+func guestTokenMAC(bookingID uint, email, expires string) string {
+	mac := hmac.New(sha256.New, guestTokenSecret)
+	mac.Write([]byte(fmt.Sprintf("%d:%s:%s", bookingID, strings.ToLower(email), expires)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
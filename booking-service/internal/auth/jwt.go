@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"booking-service/internal/constants"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ParseBearer validates the JWT issued by user-service that is sent in an
+// Authorization header and returns its claims.
+func ParseBearer(header string) (jwt.MapClaims, error) {
+	secret := os.Getenv("JWT_SECRET")
+	parts := strings.SplitN(header, " ", 2)
+	if secret == "" || len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") {
+		return nil, constants.ErrUnauthorized
+	}
+
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(parts[1], claims, func(t *jwt.Token) (interface{}, error) {
+		return []byte(secret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil || !token.Valid {
+		return nil, constants.ErrUnauthorized
+	}
+
+	return claims, nil
+}
+
+// BearerUserID returns the user the JWT in the Authorization header belongs
+// to.
+func BearerUserID(header string) (uint, error) {
+	claims, err := ParseBearer(header)
+	if err != nil {
+		return 0, err
+	}
+
+	userID, _ := claims["user_id"].(float64)
+	if userID < 1 {
+		return 0, constants.ErrUnauthorized
+	}
+	return uint(userID), nil
+}
@@ -1,12 +1,16 @@
 package clients
 
 import (
+	"booking-service/internal/constants"
 	"booking-service/internal/dto"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -50,3 +54,161 @@ func GetSession(sessionID uint) (*dto.SessionResponse, error) {
 
 	return &session, nil
 }
+
+func GetSessionSeats(sessionID uint) ([]dto.SessionSeatResponse, error) {
+	cinemaServiceUrl := getCinemaServiceURL()
+	url := fmt.Sprintf("%s/sessions/%d/seats", cinemaServiceUrl, sessionID)
+
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cinema service returned status %d for seats of session %d", resp.StatusCode, sessionID)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var seats []dto.SessionSeatResponse
+
+	if err := json.Unmarshal(body, &seats); err != nil {
+		return nil, err
+	}
+
+	return seats, nil
+}
+
+func GetHall(hallID uint) (*dto.HallResponse, error) {
+	cinemaServiceUrl := getCinemaServiceURL()
+	url := fmt.Sprintf("%s/halls/%d", cinemaServiceUrl, hallID)
+
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cinema service returned status %d for hall %d", resp.StatusCode, hallID)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var hall dto.HallResponse
+
+	if err := json.Unmarshal(body, &hall); err != nil {
+		return nil, err
+	}
+
+	return &hall, nil
+}
+
+func GetCinema(cinemaID uint) (*dto.CinemaResponse, error) {
+	cinemaServiceUrl := getCinemaServiceURL()
+	url := fmt.Sprintf("%s/cinemas/%d", cinemaServiceUrl, cinemaID)
+
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, constants.ErrCinemaNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cinema service returned status %d for cinema %d", resp.StatusCode, cinemaID)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var cinema dto.CinemaResponse
+
+	if err := json.Unmarshal(body, &cinema); err != nil {
+		return nil, err
+	}
+
+	return &cinema, nil
+}
+
+// GetHalls fetches halls through the cinema service batch lookup, splitting
+// the ids into chunks the endpoint accepts. Unknown halls are missing from the
+// result.
+func GetHalls(hallIDs []uint) (map[uint]dto.HallResponse, error) {
+	halls := make(map[uint]dto.HallResponse, len(hallIDs))
+
+	for start := 0; start < len(hallIDs); start += constants.MaxBatchIDs {
+		end := min(start+constants.MaxBatchIDs, len(hallIDs))
+		url := fmt.Sprintf("%s/halls?ids=%s", getCinemaServiceURL(), joinIDs(hallIDs[start:end]))
+
+		resp, err := httpClient.Get(url)
+		if err != nil {
+			return nil, err
+		}
+
+		var list []dto.HallResponse
+		if resp.StatusCode != http.StatusOK {
+			err = fmt.Errorf("cinema service returned status %d for halls %v", resp.StatusCode, hallIDs[start:end])
+		} else {
+			err = json.NewDecoder(resp.Body).Decode(&list)
+		}
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, hall := range list {
+			halls[hall.ID] = hall
+		}
+	}
+
+	return halls, nil
+}
+
+// GetBookingCalendar fetches the .ics attachment of a booking. cinema-service
+// renders it from the session, so the calendar format is kept in one service.
+func GetBookingCalendar(sessionID, bookingID uint, seatIDs []uint, cancelled bool) ([]byte, error) {
+	query := url.Values{}
+	query.Set("booking_id", strconv.FormatUint(uint64(bookingID), 10))
+	if len(seatIDs) > 0 {
+		query.Set("seat_ids", joinIDs(seatIDs))
+	}
+	if cancelled {
+		query.Set("cancelled", "true")
+	}
+
+	resp, err := httpClient.Get(fmt.Sprintf("%s/sessions/%d/booking.ics?%s", getCinemaServiceURL(), sessionID, query.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cinema service returned status %d for the calendar of booking %d", resp.StatusCode, bookingID)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+func joinIDs(ids []uint) string {
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		parts = append(parts, strconv.FormatUint(uint64(id), 10))
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,47 @@
+package clients
+
+import (
+	"booking-service/internal/dto"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+)
+
+func getMovieServiceURL() string {
+	url := os.Getenv("MOVIE_SERVICE_URL")
+	if url == "" {
+		return "http://localhost:8083"
+	}
+	return url
+}
+
+func GetMovie(movieID uint) (*dto.MovieResponse, error) {
+	movieServiceUrl := getMovieServiceURL()
+	url := fmt.Sprintf("%s/movies/%d", movieServiceUrl, movieID)
+
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("movie service returned status %d for movie %d", resp.StatusCode, movieID)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var movie dto.MovieResponse
+
+	if err := json.Unmarshal(body, &movie); err != nil {
+		return nil, err
+	}
+
+	return &movie, nil
+}
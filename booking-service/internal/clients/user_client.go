@@ -0,0 +1,80 @@
+package clients
+
+import (
+	"booking-service/internal/constants"
+	"booking-service/internal/dto"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+)
+
+func getUserServiceURL() string {
+	url := os.Getenv("USER_SERVICE_URL")
+	if url == "" {
+		return "http://localhost:8080"
+	}
+	return url
+}
+
+func GetNotificationPreferences(userID uint) (*dto.NotificationPreferencesResponse, error) {
+	userServiceUrl := getUserServiceURL()
+	url := fmt.Sprintf("%s/internal/users/%d/notification-preferences", userServiceUrl, userID)
+
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, constants.ErrUserNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("user service returned status %d for user %d", resp.StatusCode, userID)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var prefs dto.NotificationPreferencesResponse
+
+	if err := json.Unmarshal(body, &prefs); err != nil {
+		return nil, err
+	}
+
+	return &prefs, nil
+}
+
+func GetUserBirthdate(userID uint) (*dto.UserBirthdateResponse, error) {
+	userServiceUrl := getUserServiceURL()
+	url := fmt.Sprintf("%s/internal/users/%d/birthdate", userServiceUrl, userID)
+
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("user service returned status %d for user %d", resp.StatusCode, userID)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var user dto.UserBirthdateResponse
+
+	if err := json.Unmarshal(body, &user); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
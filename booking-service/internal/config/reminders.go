@@ -0,0 +1,24 @@
+package config
+
+import (
+	"booking-service/internal/constants"
+	"os"
+	"strconv"
+	"time"
+)
+
+func ReminderBefore() time.Duration {
+	return minutesFromEnv("REMINDER_BEFORE_MINUTES", constants.DefaultReminderBeforeMinutes)
+}
+
+func HoldWarningBefore() time.Duration {
+	return minutesFromEnv("HOLD_WARNING_MINUTES", constants.DefaultHoldWarningMinutes)
+}
+
+func minutesFromEnv(key string, def int) time.Duration {
+	minutes, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || minutes <= 0 {
+		return time.Duration(def) * time.Minute
+	}
+	return time.Duration(minutes) * time.Minute
+}
@@ -9,3 +9,29 @@ var ErrBookingExpired = errors.New("the reservation time has expired")
 var ErrInvalidID = errors.New("invalid id")
 var ErrBookingAlreadyConfirmed = errors.New("booking already confirmed")
 var ErrInvalidBookingStatus = errors.New("invalid booking status")
+var ErrSeatNotInHall = errors.New("seat does not belong to the session hall")
+var ErrIncompleteSeatGroup = errors.New("seats of this group must be booked together")
+var ErrNoContiguousSeats = errors.New("no free seats next to each other for this party size")
+var ErrSeatBlocked = errors.New("seat is not on sale for this session")
+var ErrInvalidReportFilter = errors.New("invalid report filter")
+var ErrCinemaNotFound = errors.New("cinema not found")
+var ErrUserNotFound = errors.New("user not found")
+var ErrUnauthorized = errors.New("missing or invalid authorization token")
+var ErrGuestContactRequired = errors.New("guest bookings require guest_email and guest_phone")
+var ErrInvalidGuestToken = errors.New("invalid guest token")
+var ErrGuestUserID = errors.New("guest bookings cannot set user_id")
+var ErrNoSeatsSelected = errors.New("at least one seat must be selected")
+var ErrDuplicateSeat = errors.New("seat is selected more than once")
+var ErrInvalidTicketCategory = errors.New("invalid ticket category")
+var ErrAgeRestricted = errors.New("the movie is not allowed for the viewer's age")
+var ErrBirthdateRequired = errors.New("birthdate is required to book an age-restricted movie")
+var ErrTicketCategoryNotAllowed = errors.New("ticket category is not allowed for this movie")
+var ErrProductNotFound = errors.New("product not found")
+var ErrProductUnavailable = errors.New("product is not available")
+var ErrInsufficientStock = errors.New("insufficient product stock")
+var ErrSessionAlreadyStarted = errors.New("session already started")
+var ErrOutsideSalesWindow = errors.New("tickets for this session are not on sale")
+var ErrVoucherNotFound = errors.New("voucher not found")
+var ErrVoucherInactive = errors.New("voucher is not active")
+var ErrVoucherExpired = errors.New("voucher has expired")
+var ErrVoucherEmpty = errors.New("voucher balance is empty")
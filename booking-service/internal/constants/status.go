@@ -1,5 +1,7 @@
 package constants
 
+import "time"
+
 type BookingStatus string
 
 const (
@@ -13,10 +15,53 @@ const (
 type PaymentStatus string
 
 const (
-	PaymentPending PaymentStatus = "pending"
-	PaymentPaid    PaymentStatus = "paid"
+	PaymentPending  PaymentStatus = "pending"
+	PaymentPaid     PaymentStatus = "paid"
+	PaymentRefunded PaymentStatus = "refunded"
+)
+
+type VoucherTransactionType string
+
+const (
+	VoucherIssue    VoucherTransactionType = "issue"
+	VoucherPurchase VoucherTransactionType = "purchase"
+	VoucherRedeem   VoucherTransactionType = "redeem"
+	VoucherRefund   VoucherTransactionType = "refund"
+)
+
+// PurchasedVoucherValidity is how long a gift card bought by a customer can
+// be used.
+const PurchasedVoucherValidity = 365 * 24 * time.Hour
+
+const (
+	VoucherStatusActive   = "active"
+	VoucherStatusInactive = "inactive"
+	VoucherStatusExpired  = "expired"
+	VoucherStatusEmpty    = "empty"
 )
 
 const (
 	BookingTimeoutMinutes = 15
+
+	DefaultReminderBeforeMinutes = 120
+	DefaultHoldWarningMinutes    = 5
+)
+
+// GuestTokenValidity is how long after the session a guest token still opens
+// the booking.
+const GuestTokenValidity = 30 * 24 * time.Hour
+
+// MaxBatchIDs matches the ids limit of the cinema service batch lookups.
+const MaxBatchIDs = 100
+
+const (
+	SessionsTopic        = "sessions"
+	ConsumerGroupID      = "booking-service"
+	EventBookingsRevoked = "session.bookings_revoked"
+	UsersCreatedTopic    = "user.created"
+)
+
+const (
+	EventReminder     = "booking.reminder"
+	EventHoldExpiring = "booking.hold_expiring"
 )
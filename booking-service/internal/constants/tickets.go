@@ -0,0 +1,17 @@
+package constants
+
+type TicketCategory string
+
+const (
+	TicketAdult   TicketCategory = "adult"
+	TicketChild   TicketCategory = "child"
+	TicketStudent TicketCategory = "student"
+	TicketSenior  TicketCategory = "senior"
+)
+
+// TicketCategories are the categories cinema-service prices every seat for.
+var TicketCategories = []TicketCategory{TicketAdult, TicketChild, TicketStudent, TicketSenior}
+
+// ChildTicketMaxMinAge is the highest movie minimum age for which child
+// tickets may still be sold.
+const ChildTicketMaxMinAge = 12
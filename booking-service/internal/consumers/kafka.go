@@ -0,0 +1,132 @@
+package consumers
+
+import (
+	"booking-service/internal/config"
+	"booking-service/internal/constants"
+	"booking-service/internal/dto"
+	"booking-service/internal/services"
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	retryDelay    = 5 * time.Second
+	maxRetryDelay = 5 * time.Minute
+)
+
+func getKafkaBroker() string {
+	broker := os.Getenv("KAFKA_BROKER")
+	if broker == "" {
+		config.GetLogger().Warn("KAFKA_BROKER not set, using default localhost:9092")
+		return "localhost:9092"
+	}
+	return broker
+}
+
+// StartSessionEventsConsumer listens to cinema-service session events and
+// revokes the bookings of force-deleted halls, seats and sessions.
+func StartSessionEventsConsumer(ctx context.Context, service services.BookingService) {
+	logger := config.GetLogger()
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: []string{getKafkaBroker()},
+		Topic:   constants.SessionsTopic,
+		GroupID: constants.ConsumerGroupID,
+	})
+	defer reader.Close()
+
+	logger.Info("Kafka consumer started", "topic", constants.SessionsTopic)
+
+	for {
+		msg, err := reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				logger.Info("Kafka consumer stopped", "topic", constants.SessionsTopic)
+				return
+			}
+			logger.Error("Failed to read Kafka message", "error", err, "topic", constants.SessionsTopic)
+			continue
+		}
+
+		var event dto.BookingsRevokedEvent
+		if err := json.Unmarshal(msg.Value, &event); err != nil {
+			logger.Error("Failed to decode Kafka message", "error", err, "offset", msg.Offset)
+		} else if event.Event == constants.EventBookingsRevoked {
+			// The offset is only committed once every booking is revoked, so
+			// refunds are retried instead of being dropped.
+			if !retryUntilDone(ctx, "revoke bookings", msg, func() error { return service.RevokeBookings(event) }) {
+				logger.Info("Kafka consumer stopped", "topic", constants.SessionsTopic)
+				return
+			}
+		}
+
+		if err := reader.CommitMessages(ctx, msg); err != nil {
+			logger.Error("Failed to commit Kafka message", "error", err, "topic", constants.SessionsTopic, "offset", msg.Offset)
+		}
+	}
+}
+
+// StartUserCreatedConsumer attaches the guest bookings made with a new user's
+// email to that user as soon as user-service reports the registration.
+func StartUserCreatedConsumer(ctx context.Context, service services.BookingService) {
+	logger := config.GetLogger()
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: []string{getKafkaBroker()},
+		Topic:   constants.UsersCreatedTopic,
+		GroupID: constants.ConsumerGroupID,
+	})
+	defer reader.Close()
+
+	logger.Info("Kafka consumer started", "topic", constants.UsersCreatedTopic)
+
+	for {
+		msg, err := reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				logger.Info("Kafka consumer stopped", "topic", constants.UsersCreatedTopic)
+				return
+			}
+			logger.Error("Failed to read Kafka message", "error", err, "topic", constants.UsersCreatedTopic)
+			continue
+		}
+
+		var event dto.UserCreatedEvent
+		if err := json.Unmarshal(msg.Value, &event); err != nil {
+			logger.Error("Failed to decode Kafka message", "error", err, "offset", msg.Offset)
+		} else if event.ID != 0 {
+			attach := func() error {
+				_, err := service.AttachGuestBookingsByEmail(event.Email, event.ID)
+				return err
+			}
+			if !retryUntilDone(ctx, "attach guest bookings", msg, attach) {
+				logger.Info("Kafka consumer stopped", "topic", constants.UsersCreatedTopic)
+				return
+			}
+		}
+
+		if err := reader.CommitMessages(ctx, msg); err != nil {
+			logger.Error("Failed to commit Kafka message", "error", err, "topic", constants.UsersCreatedTopic, "offset", msg.Offset)
+		}
+	}
+}
+
+// retryUntilDone runs fn with a growing delay until it succeeds. It returns
+// false when ctx is cancelled first.
+func retryUntilDone(ctx context.Context, action string, msg kafka.Message, fn func() error) bool {
+	for delay := retryDelay; ; delay = min(delay*2, maxRetryDelay) {
+		err := fn()
+		if err == nil {
+			return true
+		}
+		config.GetLogger().Error("Failed to "+action+", retrying", "error", err, "topic", msg.Topic, "offset", msg.Offset, "retry_in", delay)
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(delay):
+		}
+	}
+}
@@ -2,13 +2,46 @@ package dto
 
 import (
 	"booking-service/internal/constants"
+	"booking-service/internal/models"
 	"time"
 )
 
 type BookingCreateRequest struct {
-	SessionID uint   `json:"session_id" binding:"required"`
-	UserID    uint   `json:"user_id" binding:"required"`
-	SeatsID   []uint `json:"seats_id" binding:"required,min=1"`
+	SessionID  uint   `json:"session_id" binding:"required"`
+	UserID     uint   `json:"user_id"`
+	GuestEmail string `json:"guest_email" binding:"omitempty,email"`
+	GuestPhone string `json:"guest_phone" binding:"omitempty,min=5,max=20"`
+
+	GuestBirthdate string `json:"guest_birthdate" binding:"omitempty,datetime=2006-01-02"`
+	SeatsID        []uint `json:"seats_id"`
+
+	Seats []BookingSeatRequest `json:"seats" binding:"omitempty,dive"`
+	Items []BookingItemRequest `json:"items" binding:"omitempty,dive"`
+}
+
+type BookingSeatRequest struct {
+	SeatID   uint                     `json:"seat_id" binding:"required"`
+	Category constants.TicketCategory `json:"category"`
+}
+
+type GuestBookingResponse struct {
+	models.Booking
+	GuestToken string `json:"guest_token"`
+}
+
+// AttachGuestBookingsRequest moves guest bookings to the caller's account.
+// Every booking comes with its guest token as proof that the caller made it.
+type AttachGuestBookingsRequest struct {
+	Bookings []GuestBookingClaim `json:"bookings" binding:"required,min=1,max=20,dive"`
+}
+
+type GuestBookingClaim struct {
+	BookingID  uint   `json:"booking_id" binding:"required"`
+	GuestToken string `json:"guest_token" binding:"required"`
+}
+
+type BookingConfirmRequest struct {
+	VoucherCode string `json:"voucher_code"`
 }
 
 type BookingUpdateRequest struct {
@@ -21,10 +54,127 @@ type SessionResponse struct {
 	StartTime time.Time `json:"start_time"`
 	EndTime   time.Time `json:"end_time"`
 	Status    string    `json:"status"`
+
+	SalesOpenAt   *time.Time `json:"sales_open_at"`
+	SalesCloseAt  *time.Time `json:"sales_close_at"`
+	PresaleOpenAt *time.Time `json:"presale_open_at"`
+}
+
+type SessionSeatResponse struct {
+	ID      uint   `json:"id"`
+	Row     int    `json:"row"`
+	Number  int    `json:"number"`
+	Type    string `json:"type"`
+	Price   int    `json:"price"`
+	Blocked bool   `json:"blocked"`
+
+	// CategoryPrices is the seat price per ticket category, set by the
+	// cinema-service pricing rules.
+	CategoryPrices map[constants.TicketCategory]int `json:"category_prices"`
+
+	X       *float64 `json:"x,omitempty"`
+	Y       *float64 `json:"y,omitempty"`
+	Section string   `json:"section,omitempty"`
+
+	GroupID      *uint `json:"group_id,omitempty"`
+	SellTogether bool  `json:"sell_together,omitempty"`
+}
+
+type MovieResponse struct {
+	ID        uint   `json:"id"`
+	Title     string `json:"title"`
+	AgeRating string `json:"age_rating"`
+	MinAge    int    `json:"min_age"`
+}
+
+type UserBirthdateResponse struct {
+	ID        uint   `json:"id"`
+	Birthdate string `json:"birthdate"`
+}
+
+type CinemaResponse struct {
+	ID       uint           `json:"id"`
+	Name     string         `json:"name"`
+	Timezone string         `json:"timezone"`
+	Halls    []HallResponse `json:"halls"`
+}
+
+type HallResponse struct {
+	ID     uint `json:"id"`
+	Number int  `json:"number"`
+	Seats  []struct {
+		ID uint `json:"id"`
+	} `json:"seats"`
 }
 
 type BookingConfirmResponse struct {
-	SessionID     uint                     `json:"session_id"`
-	UserID        uint                     `json:"user_id"`
-	BookingStatus *constants.BookingStatus `json:"booking_status"`
+	Event            string                   `json:"event"`
+	BookingID        uint                     `json:"booking_id"`
+	SessionID        uint                     `json:"session_id"`
+	UserID           uint                     `json:"user_id"`
+	GuestEmail       string                   `json:"guest_email,omitempty"`
+	BookingStatus    *constants.BookingStatus `json:"booking_status"`
+	SeatsCount       int                      `json:"seats_count"`
+	TotalPrice       int                      `json:"total_price"`
+	ExpiresAt        time.Time                `json:"expires_at"`
+	SessionStartTime time.Time                `json:"session_start_time"`
+}
+
+type NotificationPreferencesResponse struct {
+	SessionReminders bool `json:"session_reminders"`
+	HoldWarnings     bool `json:"hold_warnings"`
+}
+
+type ActiveBookingsResponse struct {
+	Count      int             `json:"count"`
+	BookingIDs []uint          `json:"booking_ids"`
+	Bookings   []ActiveBooking `json:"bookings"`
+}
+
+// ActiveBooking tells notification-service whom to contact about a booking
+// when its session is cancelled.
+type ActiveBooking struct {
+	ID         uint   `json:"id"`
+	SessionID  uint   `json:"session_id"`
+	UserID     uint   `json:"user_id"`
+	GuestEmail string `json:"guest_email"`
+	SeatsCount int    `json:"seats_count"`
+}
+
+// BookingsRevokedEvent is published by cinema-service when a hall, seat or
+// session is force-deleted while it still has active bookings.
+type BookingsRevokedEvent struct {
+	Event      string    `json:"event"`
+	Reason     string    `json:"reason"`
+	SessionIDs []uint    `json:"session_ids"`
+	SeatIDs    []uint    `json:"seat_ids"`
+	RevokedAt  time.Time `json:"revoked_at"`
+}
+
+// UserCreatedEvent is published by user-service when someone registers.
+type UserCreatedEvent struct {
+	ID    uint   `json:"id"`
+	Email string `json:"email"`
+}
+
+// BestSeatsRequest asks for the best block of free seats next to each other.
+// With Hold set the seats are booked right away as a pending booking, so the
+// contact fields follow the same rules as BookingCreateRequest.
+type BestSeatsRequest struct {
+	SessionID uint   `json:"session_id" binding:"required"`
+	PartySize int    `json:"party_size" binding:"required,min=1,max=10"`
+	SeatType  string `json:"seat_type" binding:"omitempty,oneof=standard vip wheelchair"`
+	Hold      bool   `json:"hold"`
+
+	UserID         uint   `json:"user_id"`
+	GuestEmail     string `json:"guest_email" binding:"omitempty,email"`
+	GuestPhone     string `json:"guest_phone" binding:"omitempty,min=5,max=20"`
+	GuestBirthdate string `json:"guest_birthdate" binding:"omitempty,datetime=2006-01-02"`
+}
+
+type BestSeatsResponse struct {
+	Seats      []SessionSeatResponse `json:"seats"`
+	Score      float64               `json:"score"`
+	Booking    *models.Booking       `json:"booking,omitempty"`
+	GuestToken string                `json:"guest_token,omitempty"`
 }
@@ -0,0 +1,20 @@
+package dto
+
+type ProductCreateRequest struct {
+	Name   string `json:"name" binding:"required"`
+	Price  int    `json:"price" binding:"min=0"`
+	Stock  int    `json:"stock" binding:"min=0"`
+	Active *bool  `json:"active"`
+}
+
+type ProductUpdateRequest struct {
+	Name   *string `json:"name" binding:"omitempty,min=1"`
+	Price  *int    `json:"price" binding:"omitempty,min=0"`
+	Stock  *int    `json:"stock" binding:"omitempty,min=0"`
+	Active *bool   `json:"active"`
+}
+
+type BookingItemRequest struct {
+	ProductID uint `json:"product_id" binding:"required"`
+	Quantity  int  `json:"quantity" binding:"required,min=1"`
+}
@@ -0,0 +1,54 @@
+package dto
+
+import "time"
+
+type ReportQuery struct {
+	From     string `form:"from"`
+	To       string `form:"to"`
+	CinemaID uint   `form:"cinema_id"`
+	GroupBy  string `form:"group_by"`
+	Format   string `form:"format"`
+}
+
+type ReportFilter struct {
+	From     *time.Time
+	To       *time.Time
+	CinemaID uint
+	HallIDs  []uint
+	GroupBy  string
+}
+
+type SalesReportRow struct {
+	Key         string `json:"key"`
+	Bookings    int64  `json:"bookings"`
+	TicketsSold int64  `json:"tickets_sold"`
+	Revenue     int64  `json:"revenue"`
+}
+
+type SessionSalesRow struct {
+	SessionID        uint      `json:"session_id"`
+	MovieID          uint      `json:"movie_id"`
+	HallID           uint      `json:"hall_id"`
+	SessionStartTime time.Time `json:"session_start_time"`
+	TicketsSold      int64     `json:"tickets_sold"`
+}
+
+type OccupancyReportRow struct {
+	SessionSalesRow
+	Capacity         int     `json:"capacity"`
+	OccupancyPercent float64 `json:"occupancy_percent"`
+}
+
+type StatusCountRow struct {
+	BookingStatus string
+	Count         int64
+}
+
+type CancellationReport struct {
+	TotalBookings    int64   `json:"total_bookings"`
+	Confirmed        int64   `json:"confirmed"`
+	Cancelled        int64   `json:"cancelled"`
+	Expired          int64   `json:"expired"`
+	CancellationRate float64 `json:"cancellation_rate"`
+	ExpiryRate       float64 `json:"expiry_rate"`
+}
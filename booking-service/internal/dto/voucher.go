@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"booking-service/internal/models"
+	"time"
+)
+
+type VoucherCreateRequest struct {
+	Amount         int        `json:"amount" binding:"required,min=1"`
+	ExpiresAt      *time.Time `json:"expires_at"`
+	PurchaserEmail string     `json:"purchaser_email" binding:"omitempty,email"`
+}
+
+// VoucherPurchaseRequest buys a gift card for the caller.
+type VoucherPurchaseRequest struct {
+	Amount int `json:"amount" binding:"required,min=100,max=50000"`
+}
+
+// VoucherBalanceResponse is what anyone holding a code may see about it.
+type VoucherBalanceResponse struct {
+	Code      string     `json:"code"`
+	Balance   int        `json:"balance"`
+	Status    string     `json:"status"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+type VoucherResponse struct {
+	models.Voucher
+	Transactions []models.VoucherTransaction `json:"transactions"`
+}
@@ -104,15 +104,26 @@ func InitKafkaWriter() {
 }
 
 func PublishOrderCreated(booking models.Booking) error {
+	return PublishBookingEvent(booking, "booking."+string(booking.BookingStatus))
+}
+
+func PublishBookingEvent(booking models.Booking, eventName string) error {
 	if kafkaWriter == nil {
 		config.GetLogger().Error("Kafka writer is not initialized")
 		return fmt.Errorf("kafka writer is not initialized")
 	}
 
 	event := dto.BookingConfirmResponse{
-		SessionID:     booking.SessionID,
-		UserID:        booking.UserID,
-		BookingStatus: &booking.BookingStatus,
+		Event:            eventName,
+		BookingID:        booking.ID,
+		SessionID:        booking.SessionID,
+		UserID:           booking.UserID,
+		GuestEmail:       booking.GuestEmail,
+		BookingStatus:    &booking.BookingStatus,
+		SeatsCount:       booking.SeatsCount,
+		TotalPrice:       booking.TotalPrice,
+		ExpiresAt:        booking.ExpiresAt,
+		SessionStartTime: booking.SessionStartTime,
 	}
 
 	eventJSON, err := json.Marshal(event)
@@ -135,6 +146,6 @@ func PublishOrderCreated(booking models.Booking) error {
 		return err
 	}
 
-	config.GetLogger().Info("Event published to Kafka", "booking_id", booking.ID, "topic", kafkaTopic)
+	config.GetLogger().Info("Event published to Kafka", "booking_id", booking.ID, "event", eventName, "topic", kafkaTopic)
 	return nil
 }
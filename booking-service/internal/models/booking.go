@@ -9,19 +9,33 @@ type Booking struct {
 	Base
 
 	SessionID     uint                    `json:"session_id" gorm:"not null;index"`
+	MovieID       uint                    `json:"movie_id" gorm:"index"`
+	HallID        uint                    `json:"hall_id" gorm:"index"`
 	UserID        uint                    `json:"user_id" gorm:"not null;index"`
+	GuestEmail    string                  `json:"guest_email,omitempty" gorm:"index"`
+	GuestPhone    string                  `json:"guest_phone,omitempty"`
 	BookingStatus constants.BookingStatus `json:"booking_status" gorm:"default:pending;index"`
 	PaymentStatus constants.PaymentStatus `json:"payment_status" gorm:"default:pending;index"`
 	ExpiresAt     time.Time               `json:"expires_at" gorm:"not null;index"`
+	SeatsCount    int                     `json:"seats_count" gorm:"not null;default:0"`
+	TotalPrice    int                     `json:"total_price" gorm:"not null;default:0"`
+	VoucherID     *uint                   `json:"voucher_id,omitempty" gorm:"index"`
+	VoucherAmount int                     `json:"voucher_amount" gorm:"not null;default:0"`
 	BookedSeats   []BookedSeat            `json:"booked_seats" gorm:"foreignKey:BookingID"`
+	Items         []BookingItem           `json:"items" gorm:"foreignKey:BookingID"`
 
 	SessionStartTime time.Time `json:"session_start_time" gorm:"not null;index"`
 	SessionEndTime   time.Time `json:"session_end_time" gorm:"not null;index"`
+
+	ReminderSentAt    *time.Time `json:"-"`
+	HoldWarningSentAt *time.Time `json:"-"`
 }
 
 type BookedSeat struct {
 	Base
 
-	BookingID uint `json:"booking_id" gorm:"not null;index"`
-	SeatID    uint `json:"seat_id" gorm:"not null;index"`
+	BookingID uint                     `json:"booking_id" gorm:"not null;index"`
+	SeatID    uint                     `json:"seat_id" gorm:"not null;index"`
+	Category  constants.TicketCategory `json:"category" gorm:"type:varchar(20);not null;default:adult"`
+	Price     int                      `json:"price" gorm:"not null;default:0"`
 }
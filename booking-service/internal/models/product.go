@@ -0,0 +1,21 @@
+package models
+
+type Product struct {
+	Base
+
+	Name   string `json:"name" gorm:"not null;uniqueIndex"`
+	Price  int    `json:"price" gorm:"not null"`
+	Stock  int    `json:"stock" gorm:"not null;default:0"`
+	Active bool   `json:"active" gorm:"not null;default:true"`
+}
+
+type BookingItem struct {
+	Base
+
+	BookingID  uint   `json:"booking_id" gorm:"not null;index"`
+	ProductID  uint   `json:"product_id" gorm:"not null;index"`
+	Name       string `json:"name" gorm:"not null"`
+	Quantity   int    `json:"quantity" gorm:"not null"`
+	UnitPrice  int    `json:"unit_price" gorm:"not null"`
+	TotalPrice int    `json:"total_price" gorm:"not null"`
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"booking-service/internal/constants"
+	"time"
+)
+
+type Voucher struct {
+	Base
+
+	Code           string     `json:"code" gorm:"type:varchar(32);not null;uniqueIndex"`
+	InitialBalance int        `json:"initial_balance" gorm:"not null"`
+	Balance        int        `json:"balance" gorm:"not null"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty" gorm:"index"`
+	Active         bool       `json:"active" gorm:"not null;default:true"`
+	PurchaserEmail string     `json:"purchaser_email,omitempty"`
+
+	// Set for vouchers bought by a customer rather than issued by staff.
+	PurchaserUserID *uint                   `json:"purchaser_user_id,omitempty" gorm:"index"`
+	PaymentStatus   constants.PaymentStatus `json:"payment_status,omitempty" gorm:"type:varchar(20)"`
+}
+
+type VoucherTransaction struct {
+	Base
+
+	VoucherID    uint                             `json:"voucher_id" gorm:"not null;index"`
+	BookingID    *uint                            `json:"booking_id,omitempty" gorm:"index"`
+	Type         constants.VoucherTransactionType `json:"type" gorm:"type:varchar(20);not null"`
+	Amount       int                              `json:"amount" gorm:"not null"`
+	BalanceAfter int                              `json:"balance_after" gorm:"not null"`
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"booking-service/internal/config"
+	"booking-service/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type BookingItemRepository interface {
+	Create(tx *gorm.DB, bookingID uint, items []models.BookingItem) error
+}
+
+type gormBookingItem struct {
+	db *gorm.DB
+}
+
+func NewBookingItemRepository(db *gorm.DB) BookingItemRepository {
+	return &gormBookingItem{
+		db: db,
+	}
+}
+
+func (r *gormBookingItem) Create(tx *gorm.DB, bookingID uint, items []models.BookingItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	for i := range items {
+		items[i].BookingID = bookingID
+	}
+
+	if err := tx.Create(&items).Error; err != nil {
+		config.GetLogger().Error("Failed to create booking items", "error", err, "booking_id", bookingID)
+		return err
+	}
+
+	return nil
+}
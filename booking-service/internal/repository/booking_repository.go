@@ -21,6 +21,15 @@ type BookingRepository interface {
 	CheckBooked(sessionID uint, seatsID []uint) ([]uint, error)
 	FindExpiredPendingBookings() ([]models.Booking, error)
 	FindBookingsForEndedSessions() ([]models.Booking, error)
+	ListByUserID(userID uint) ([]models.Booking, error)
+	AttachGuestBookings(ids []uint, userID uint) (int64, error)
+	AttachGuestBookingsByEmail(email string, userID uint) (int64, error)
+	FindBookingsForReminder(before time.Duration) ([]models.Booking, error)
+	FindPendingBookingsNearExpiry(before time.Duration) ([]models.Booking, error)
+	MarkReminderSent(id uint) error
+	MarkHoldWarningSent(id uint) error
+	ListBookedSeatIDs(sessionID uint) ([]uint, error)
+	ListActive(sessionIDs, seatIDs []uint) ([]models.Booking, error)
 }
 
 type gormBookingRepository struct {
@@ -46,7 +55,7 @@ func (r *gormBookingRepository) Create(tx *gorm.DB, booking *models.Booking) (*m
 func (r *gormBookingRepository) List() ([]models.Booking, error) {
 	var bookings []models.Booking
 
-	if err := r.db.Preload("BookedSeats").Find(&bookings).Error; err != nil {
+	if err := r.db.Preload("BookedSeats").Preload("Items").Find(&bookings).Error; err != nil {
 		config.GetLogger().Error("Failed to get bookings list", "error", err)
 		return nil, err
 	}
@@ -57,7 +66,7 @@ func (r *gormBookingRepository) List() ([]models.Booking, error) {
 func (r *gormBookingRepository) GetByID(id uint) (*models.Booking, error) {
 	var booking models.Booking
 
-	if err := r.db.Preload("BookedSeats").First(&booking, id).Error; err != nil {
+	if err := r.db.Preload("BookedSeats").Preload("Items").First(&booking, id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, constants.ErrBookingNotFound
 		}
@@ -71,7 +80,7 @@ func (r *gormBookingRepository) GetByID(id uint) (*models.Booking, error) {
 func (r *gormBookingRepository) GetByIDWithTx(tx *gorm.DB, id uint) (*models.Booking, error) {
 	var booking models.Booking
 
-	if err := tx.Preload("BookedSeats").First(&booking, id).Error; err != nil {
+	if err := tx.Preload("BookedSeats").Preload("Items").First(&booking, id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, constants.ErrBookingNotFound
 		}
@@ -165,3 +174,134 @@ func (r *gormBookingRepository) FindBookingsForEndedSessions() ([]models.Booking
 
 	return bookings, nil
 }
+
+func (r *gormBookingRepository) ListByUserID(userID uint) ([]models.Booking, error) {
+	var bookings []models.Booking
+
+	if err := r.db.Preload("BookedSeats").Preload("Items").Where("user_id = ?", userID).Order("created_at DESC").Find(&bookings).Error; err != nil {
+		config.GetLogger().Error("Failed to get bookings by user_id", "error", err, "user_id", userID)
+		return nil, err
+	}
+
+	return bookings, nil
+}
+
+func (r *gormBookingRepository) AttachGuestBookings(ids []uint, userID uint) (int64, error) {
+	res := r.db.Model(&models.Booking{}).
+		Where("user_id = 0 AND id IN ?", ids).
+		Update("user_id", userID)
+
+	if err := res.Error; err != nil {
+		config.GetLogger().Error("Failed to attach guest bookings", "error", err, "user_id", userID)
+		return 0, err
+	}
+
+	return res.RowsAffected, nil
+}
+
+func (r *gormBookingRepository) AttachGuestBookingsByEmail(email string, userID uint) (int64, error) {
+	res := r.db.Model(&models.Booking{}).
+		Where("user_id = 0 AND guest_email = ?", email).
+		Update("user_id", userID)
+
+	if err := res.Error; err != nil {
+		config.GetLogger().Error("Failed to attach guest bookings by email", "error", err, "user_id", userID)
+		return 0, err
+	}
+
+	return res.RowsAffected, nil
+}
+
+func (r *gormBookingRepository) FindBookingsForReminder(before time.Duration) ([]models.Booking, error) {
+	var bookings []models.Booking
+	now := time.Now()
+
+	err := r.db.
+		Where("booking_status = ? AND reminder_sent_at IS NULL AND session_start_time > ? AND session_start_time <= ?",
+			constants.Confirmed, now, now.Add(before)).
+		Find(&bookings).Error
+
+	if err != nil {
+		config.GetLogger().Error("Failed to find bookings for reminder", "error", err)
+		return nil, err
+	}
+
+	return bookings, nil
+}
+
+func (r *gormBookingRepository) FindPendingBookingsNearExpiry(before time.Duration) ([]models.Booking, error) {
+	var bookings []models.Booking
+	now := time.Now()
+
+	err := r.db.
+		Where("booking_status = ? AND hold_warning_sent_at IS NULL AND expires_at > ? AND expires_at <= ?",
+			constants.Pending, now, now.Add(before)).
+		Find(&bookings).Error
+
+	if err != nil {
+		config.GetLogger().Error("Failed to find pending bookings near expiry", "error", err)
+		return nil, err
+	}
+
+	return bookings, nil
+}
+
+func (r *gormBookingRepository) MarkReminderSent(id uint) error {
+	if err := r.db.Model(&models.Booking{}).Where("id = ?", id).Update("reminder_sent_at", time.Now()).Error; err != nil {
+		config.GetLogger().Error("Failed to mark reminder sent", "error", err, "booking_id", id)
+		return err
+	}
+	return nil
+}
+
+func (r *gormBookingRepository) MarkHoldWarningSent(id uint) error {
+	if err := r.db.Model(&models.Booking{}).Where("id = ?", id).Update("hold_warning_sent_at", time.Now()).Error; err != nil {
+		config.GetLogger().Error("Failed to mark hold warning sent", "error", err, "booking_id", id)
+		return err
+	}
+	return nil
+}
+
+func (r *gormBookingRepository) ListBookedSeatIDs(sessionID uint) ([]uint, error) {
+	var seatIDs = []uint{}
+
+	err := r.db.Model(&models.BookedSeat{}).
+		Joins("JOIN bookings ON booked_seats.booking_id = bookings.id").
+		Where("bookings.session_id = ? AND bookings.booking_status IN (?, ?)",
+			sessionID, constants.Pending, constants.Confirmed).
+		Pluck("booked_seats.seat_id", &seatIDs).Error
+
+	if err != nil {
+		config.GetLogger().Error("Failed to list booked seats", "error", err, "session_id", sessionID)
+		return nil, err
+	}
+
+	return seatIDs, nil
+}
+
+// ListActive returns pending and confirmed bookings for any of the sessions or
+// holding any of the seats.
+func (r *gormBookingRepository) ListActive(sessionIDs, seatIDs []uint) ([]models.Booking, error) {
+	var bookings []models.Booking
+
+	query := r.db.Where("booking_status IN (?, ?)", constants.Pending, constants.Confirmed)
+	switch {
+	case len(sessionIDs) > 0 && len(seatIDs) > 0:
+		query = query.Where("session_id IN ? OR id IN (?)", sessionIDs,
+			r.db.Model(&models.BookedSeat{}).Select("booking_id").Where("seat_id IN ?", seatIDs))
+	case len(sessionIDs) > 0:
+		query = query.Where("session_id IN ?", sessionIDs)
+	case len(seatIDs) > 0:
+		query = query.Where("id IN (?)",
+			r.db.Model(&models.BookedSeat{}).Select("booking_id").Where("seat_id IN ?", seatIDs))
+	default:
+		return []models.Booking{}, nil
+	}
+
+	if err := query.Order("id").Find(&bookings).Error; err != nil {
+		config.GetLogger().Error("Failed to list active bookings", "error", err, "session_ids", sessionIDs, "seat_ids", seatIDs)
+		return nil, err
+	}
+
+	return bookings, nil
+}
@@ -8,7 +8,7 @@ import (
 )
 
 type BookingSeatRepository interface {
-	Create(tx *gorm.DB, bookingID uint, seatList []uint) error
+	Create(tx *gorm.DB, bookingID uint, seatList []models.BookedSeat) error
 	DeleteByBookingID(tx *gorm.DB, bookingID uint) error
 }
 
@@ -22,13 +22,15 @@ func NewBookingSeatRepository(db *gorm.DB) BookingSeatRepository {
 	}
 }
 
-func (r *gormBookingSeat) Create(tx *gorm.DB, bookingID uint, seatList []uint) error {
+func (r *gormBookingSeat) Create(tx *gorm.DB, bookingID uint, seatList []models.BookedSeat) error {
 	var bookedSeats = make([]models.BookedSeat, 0, len(seatList))
 
 	for _, seat := range seatList {
 		bookedSeats = append(bookedSeats, models.BookedSeat{
 			BookingID: bookingID,
-			SeatID:    seat,
+			SeatID:    seat.SeatID,
+			Category:  seat.Category,
+			Price:     seat.Price,
 		})
 	}
 
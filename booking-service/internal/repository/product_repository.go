@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"booking-service/internal/config"
+	"booking-service/internal/constants"
+	"booking-service/internal/models"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ProductRepository interface {
+	Create(product *models.Product) error
+	List(activeOnly bool) ([]models.Product, error)
+	GetByID(id uint) (*models.Product, error)
+	GetByIDForUpdate(tx *gorm.DB, id uint) (*models.Product, error)
+	Update(product *models.Product) error
+	Delete(id uint) error
+	DecrementStock(tx *gorm.DB, id uint, quantity int) error
+	IncrementStock(tx *gorm.DB, id uint, quantity int) error
+}
+
+type gormProductRepository struct {
+	db *gorm.DB
+}
+
+func NewProductRepository(db *gorm.DB) ProductRepository {
+	return &gormProductRepository{
+		db: db,
+	}
+}
+
+func (r *gormProductRepository) Create(product *models.Product) error {
+	if err := r.db.Create(product).Error; err != nil {
+		config.GetLogger().Error("Failed to create product", "error", err, "name", product.Name)
+		return err
+	}
+
+	return nil
+}
+
+func (r *gormProductRepository) List(activeOnly bool) ([]models.Product, error) {
+	var products []models.Product
+
+	query := r.db.Order("name")
+	if activeOnly {
+		query = query.Where("active = ?", true)
+	}
+
+	if err := query.Find(&products).Error; err != nil {
+		config.GetLogger().Error("Failed to get products list", "error", err)
+		return nil, err
+	}
+
+	return products, nil
+}
+
+func (r *gormProductRepository) GetByID(id uint) (*models.Product, error) {
+	return r.getByID(r.db, id)
+}
+
+func (r *gormProductRepository) GetByIDForUpdate(tx *gorm.DB, id uint) (*models.Product, error) {
+	return r.getByID(tx.Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
+
+func (r *gormProductRepository) getByID(db *gorm.DB, id uint) (*models.Product, error) {
+	var product models.Product
+
+	if err := db.First(&product, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, constants.ErrProductNotFound
+		}
+		config.GetLogger().Error("Failed to get product by id", "error", err, "product_id", id)
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+func (r *gormProductRepository) Update(product *models.Product) error {
+	if err := r.db.Save(product).Error; err != nil {
+		config.GetLogger().Error("Failed to update product", "error", err, "product_id", product.ID)
+		return err
+	}
+
+	return nil
+}
+
+func (r *gormProductRepository) Delete(id uint) error {
+	if err := r.db.Delete(&models.Product{}, id).Error; err != nil {
+		config.GetLogger().Error("Failed to delete product", "error", err, "product_id", id)
+		return err
+	}
+
+	return nil
+}
+
+func (r *gormProductRepository) DecrementStock(tx *gorm.DB, id uint, quantity int) error {
+	result := tx.Model(&models.Product{}).
+		Where("id = ? AND stock >= ?", id, quantity).
+		UpdateColumn("stock", gorm.Expr("stock - ?", quantity))
+	if result.Error != nil {
+		config.GetLogger().Error("Failed to decrement product stock", "error", result.Error, "product_id", id)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return constants.ErrInsufficientStock
+	}
+
+	return nil
+}
+
+func (r *gormProductRepository) IncrementStock(tx *gorm.DB, id uint, quantity int) error {
+	if err := tx.Model(&models.Product{}).
+		Where("id = ?", id).
+		UpdateColumn("stock", gorm.Expr("stock + ?", quantity)).Error; err != nil {
+		config.GetLogger().Error("Failed to restore product stock", "error", err, "product_id", id)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"booking-service/internal/config"
+	"booking-service/internal/constants"
+	"booking-service/internal/dto"
+	"booking-service/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type ReportRepository interface {
+	Sales(filter dto.ReportFilter) ([]dto.SalesReportRow, error)
+	SessionSales(filter dto.ReportFilter) ([]dto.SessionSalesRow, error)
+	CountByStatus(filter dto.ReportFilter) ([]dto.StatusCountRow, error)
+}
+
+type gormReportRepository struct {
+	db *gorm.DB
+}
+
+func NewReportRepository(db *gorm.DB) ReportRepository {
+	return &gormReportRepository{
+		db: db,
+	}
+}
+
+var salesGroupKeys = map[string]string{
+	"session": "CAST(session_id AS TEXT)",
+	"movie":   "CAST(movie_id AS TEXT)",
+	"hall":    "CAST(hall_id AS TEXT)",
+	"day":     "TO_CHAR(session_start_time, 'YYYY-MM-DD')",
+}
+
+func (r *gormReportRepository) Sales(filter dto.ReportFilter) ([]dto.SalesReportRow, error) {
+	keyExpr, ok := salesGroupKeys[filter.GroupBy]
+	if !ok {
+		return nil, constants.ErrInvalidReportFilter
+	}
+
+	var rows []dto.SalesReportRow
+
+	err := r.withFilter(filter).
+		Select(keyExpr+" AS key, COUNT(*) AS bookings, COALESCE(SUM(seats_count), 0) AS tickets_sold, COALESCE(SUM(total_price), 0) AS revenue").
+		Where("payment_status = ?", constants.PaymentPaid).
+		Group("1").
+		Order("1").
+		Scan(&rows).Error
+
+	if err != nil {
+		config.GetLogger().Error("Failed to build sales report", "error", err, "group_by", filter.GroupBy)
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func (r *gormReportRepository) SessionSales(filter dto.ReportFilter) ([]dto.SessionSalesRow, error) {
+	var rows []dto.SessionSalesRow
+
+	err := r.withFilter(filter).
+		Select("session_id, movie_id, hall_id, session_start_time, COALESCE(SUM(seats_count), 0) AS tickets_sold").
+		Where("payment_status = ?", constants.PaymentPaid).
+		Group("session_id, movie_id, hall_id, session_start_time").
+		Order("session_start_time, session_id").
+		Scan(&rows).Error
+
+	if err != nil {
+		config.GetLogger().Error("Failed to build session sales report", "error", err)
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func (r *gormReportRepository) CountByStatus(filter dto.ReportFilter) ([]dto.StatusCountRow, error) {
+	var rows []dto.StatusCountRow
+
+	err := r.withFilter(filter).
+		Select("booking_status, COUNT(*) AS count").
+		Group("booking_status").
+		Scan(&rows).Error
+
+	if err != nil {
+		config.GetLogger().Error("Failed to count bookings by status", "error", err)
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func (r *gormReportRepository) withFilter(filter dto.ReportFilter) *gorm.DB {
+	query := r.db.Model(&models.Booking{})
+
+	if filter.From != nil {
+		query = query.Where("session_start_time >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("session_start_time < ?", *filter.To)
+	}
+	if filter.CinemaID != 0 {
+		query = query.Where("hall_id IN ?", filter.HallIDs)
+	}
+
+	return query
+}
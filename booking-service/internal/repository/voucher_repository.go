@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"booking-service/internal/config"
+	"booking-service/internal/constants"
+	"booking-service/internal/models"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type VoucherRepository interface {
+	Create(tx *gorm.DB, voucher *models.Voucher) error
+	GetByCode(code string) (*models.Voucher, error)
+	GetByCodeForUpdate(tx *gorm.DB, code string) (*models.Voucher, error)
+	GetByIDForUpdate(tx *gorm.DB, id uint) (*models.Voucher, error)
+	UpdateBalance(tx *gorm.DB, id uint, balance int) error
+	Deactivate(id uint) error
+	AddTransaction(tx *gorm.DB, transaction *models.VoucherTransaction) error
+	ListTransactions(voucherID uint) ([]models.VoucherTransaction, error)
+	ListByPurchaser(userID uint) ([]models.Voucher, error)
+}
+
+type gormVoucherRepository struct {
+	db *gorm.DB
+}
+
+func NewVoucherRepository(db *gorm.DB) VoucherRepository {
+	return &gormVoucherRepository{
+		db: db,
+	}
+}
+
+func (r *gormVoucherRepository) Create(tx *gorm.DB, voucher *models.Voucher) error {
+	if err := tx.Create(voucher).Error; err != nil {
+		config.GetLogger().Error("Failed to create voucher", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *gormVoucherRepository) GetByCode(code string) (*models.Voucher, error) {
+	return r.findOne(r.db, "code = ?", code)
+}
+
+func (r *gormVoucherRepository) GetByCodeForUpdate(tx *gorm.DB, code string) (*models.Voucher, error) {
+	return r.findOne(tx.Clauses(clause.Locking{Strength: "UPDATE"}), "code = ?", code)
+}
+
+func (r *gormVoucherRepository) GetByIDForUpdate(tx *gorm.DB, id uint) (*models.Voucher, error) {
+	return r.findOne(tx.Clauses(clause.Locking{Strength: "UPDATE"}), "id = ?", id)
+}
+
+func (r *gormVoucherRepository) findOne(db *gorm.DB, query string, arg interface{}) (*models.Voucher, error) {
+	var voucher models.Voucher
+
+	if err := db.Where(query, arg).First(&voucher).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, constants.ErrVoucherNotFound
+		}
+		config.GetLogger().Error("Failed to get voucher", "error", err)
+		return nil, err
+	}
+
+	return &voucher, nil
+}
+
+func (r *gormVoucherRepository) UpdateBalance(tx *gorm.DB, id uint, balance int) error {
+	if err := tx.Model(&models.Voucher{}).Where("id = ?", id).UpdateColumn("balance", balance).Error; err != nil {
+		config.GetLogger().Error("Failed to update voucher balance", "error", err, "voucher_id", id)
+		return err
+	}
+
+	return nil
+}
+
+func (r *gormVoucherRepository) Deactivate(id uint) error {
+	if err := r.db.Model(&models.Voucher{}).Where("id = ?", id).Update("active", false).Error; err != nil {
+		config.GetLogger().Error("Failed to deactivate voucher", "error", err, "voucher_id", id)
+		return err
+	}
+
+	return nil
+}
+
+func (r *gormVoucherRepository) AddTransaction(tx *gorm.DB, transaction *models.VoucherTransaction) error {
+	if err := tx.Create(transaction).Error; err != nil {
+		config.GetLogger().Error("Failed to add voucher transaction", "error", err, "voucher_id", transaction.VoucherID)
+		return err
+	}
+
+	return nil
+}
+
+func (r *gormVoucherRepository) ListTransactions(voucherID uint) ([]models.VoucherTransaction, error) {
+	var transactions []models.VoucherTransaction
+
+	if err := r.db.Where("voucher_id = ?", voucherID).Order("created_at, id").Find(&transactions).Error; err != nil {
+		config.GetLogger().Error("Failed to list voucher transactions", "error", err, "voucher_id", voucherID)
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+func (r *gormVoucherRepository) ListByPurchaser(userID uint) ([]models.Voucher, error) {
+	var vouchers []models.Voucher
+
+	if err := r.db.Where("purchaser_user_id = ?", userID).Order("created_at DESC").Find(&vouchers).Error; err != nil {
+		config.GetLogger().Error("Failed to list purchased vouchers", "error", err, "user_id", userID)
+		return nil, err
+	}
+
+	return vouchers, nil
+}
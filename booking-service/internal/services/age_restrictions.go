@@ -0,0 +1,54 @@
+package services
+
+import (
+	"booking-service/internal/clients"
+	"booking-service/internal/config"
+	"booking-service/internal/constants"
+	"booking-service/internal/dto"
+	"fmt"
+	"time"
+)
+
+const birthdateLayout = "2006-01-02"
+
+// checkAgeAllowed compares the viewer's age on the day of the session with the
+// movie's minimum age. Registered users are checked against the birthdate in
+// their profile, guests against the birthdate sent with the booking.
+func checkAgeAllowed(movie *dto.MovieResponse, req dto.BookingCreateRequest, sessionStart time.Time) error {
+	if movie.MinAge <= 0 {
+		return nil
+	}
+
+	birthdateValue := req.GuestBirthdate
+	if req.UserID != 0 {
+		user, err := clients.GetUserBirthdate(req.UserID)
+		if err != nil {
+			config.GetLogger().Error("Failed to get user birthdate", "error", err, "user_id", req.UserID)
+			return fmt.Errorf("user not found")
+		}
+		birthdateValue = user.Birthdate
+	}
+
+	if birthdateValue == "" {
+		return constants.ErrBirthdateRequired
+	}
+
+	birthdate, err := time.Parse(birthdateLayout, birthdateValue)
+	if err != nil {
+		return constants.ErrBirthdateRequired
+	}
+
+	if ageOn(birthdate, sessionStart) < movie.MinAge {
+		return fmt.Errorf("%w: %s rated movie requires age %d", constants.ErrAgeRestricted, movie.AgeRating, movie.MinAge)
+	}
+
+	return nil
+}
+
+func ageOn(birthdate, at time.Time) int {
+	age := at.Year() - birthdate.Year()
+	if at.Month() < birthdate.Month() || (at.Month() == birthdate.Month() && at.Day() < birthdate.Day()) {
+		age--
+	}
+	return age
+}
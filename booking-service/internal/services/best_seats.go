@@ -0,0 +1,191 @@
+package services
+
+import (
+	"booking-service/internal/auth"
+	"booking-service/internal/clients"
+	"booking-service/internal/config"
+	"booking-service/internal/constants"
+	"booking-service/internal/dto"
+	"math"
+	"sort"
+)
+
+const seatTypeWheelchair = "wheelchair"
+
+// idealDepth is the preferred distance from the screen as a share of the hall
+// depth: about two thirds of the way back.
+const idealDepth = 2.0 / 3.0
+
+type seatRowKey struct {
+	section string
+	row     int
+}
+
+// BestSeats picks the best block of free seats next to each other and, when
+// asked to, holds them as a pending booking.
+func (s *bookingService) BestSeats(req dto.BestSeatsRequest) (*dto.BestSeatsResponse, error) {
+	seatMap, err := clients.GetSessionSeats(req.SessionID)
+	if err != nil {
+		config.GetLogger().Error("Failed to get session seats", "error", err, "session_id", req.SessionID)
+		return nil, err
+	}
+
+	bookedIDs, err := s.bookingRepo.ListBookedSeatIDs(req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	block, score := bestSeatBlock(seatMap, bookedIDs, req.PartySize, req.SeatType)
+	if block == nil {
+		return nil, constants.ErrNoContiguousSeats
+	}
+
+	response := &dto.BestSeatsResponse{Seats: block, Score: score}
+	if !req.Hold {
+		return response, nil
+	}
+
+	seatIDs := make([]uint, 0, len(block))
+	for _, seat := range block {
+		seatIDs = append(seatIDs, seat.ID)
+	}
+
+	booking, err := s.Create(dto.BookingCreateRequest{
+		SessionID:      req.SessionID,
+		UserID:         req.UserID,
+		GuestEmail:     req.GuestEmail,
+		GuestPhone:     req.GuestPhone,
+		GuestBirthdate: req.GuestBirthdate,
+		SeatsID:        seatIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response.Booking = booking
+	if booking.UserID == 0 {
+		response.GuestToken = auth.GuestTokenFor(booking)
+	}
+	return response, nil
+}
+
+// bestSeatBlock scores every run of free seats in one row that has no aisle in
+// between and returns the best one. The hall plan coordinates are used when
+// every seat has them, otherwise seat numbers and rows stand in for them.
+// Wheelchair spaces are only offered when they are asked for.
+func bestSeatBlock(seatMap []dto.SessionSeatResponse, bookedIDs []uint, partySize int, seatType string) ([]dto.SessionSeatResponse, float64) {
+	if len(seatMap) == 0 || partySize <= 0 {
+		return nil, 0
+	}
+
+	booked := make(map[uint]bool, len(bookedIDs))
+	for _, id := range bookedIDs {
+		booked[id] = true
+	}
+
+	useCoordinates := true
+	for _, seat := range seatMap {
+		if seat.X == nil || seat.Y == nil {
+			useCoordinates = false
+			break
+		}
+	}
+	position := func(seat dto.SessionSeatResponse) (float64, float64) {
+		if useCoordinates {
+			return *seat.X, *seat.Y
+		}
+		return float64(seat.Number), float64(seat.Row)
+	}
+
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	rows := make(map[seatRowKey][]dto.SessionSeatResponse)
+	for _, seat := range seatMap {
+		x, y := position(seat)
+		minX, maxX = math.Min(minX, x), math.Max(maxX, x)
+		minY, maxY = math.Min(minY, y), math.Max(maxY, y)
+
+		key := seatRowKey{section: seat.Section, row: seat.Row}
+		rows[key] = append(rows[key], seat)
+	}
+
+	centreX := (minX + maxX) / 2
+	halfWidth := math.Max((maxX-minX)/2, 1)
+	idealY := minY + (maxY-minY)*idealDepth
+	depth := math.Max(maxY-minY, 1)
+
+	keys := make([]seatRowKey, 0, len(rows))
+	for key := range rows {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].section != keys[j].section {
+			return keys[i].section < keys[j].section
+		}
+		return keys[i].row < keys[j].row
+	})
+
+	free := func(seat dto.SessionSeatResponse) bool {
+		if booked[seat.ID] || seat.Blocked {
+			return false
+		}
+		if seatType != "" {
+			return seat.Type == seatType
+		}
+		return seat.Type != seatTypeWheelchair
+	}
+
+	var best []dto.SessionSeatResponse
+	bestPenalty := math.Inf(1)
+	for _, key := range keys {
+		row := rows[key]
+		sort.Slice(row, func(i, j int) bool { return row[i].Number < row[j].Number })
+
+		for start := 0; start+partySize <= len(row); start++ {
+			block := row[start : start+partySize]
+			if !contiguousFree(block, free) {
+				continue
+			}
+
+			ids := make([]uint, 0, partySize)
+			var sumX, sumY float64
+			for _, seat := range block {
+				ids = append(ids, seat.ID)
+				x, y := position(seat)
+				sumX += x
+				sumY += y
+			}
+			if checkSeatGroups(seatMap, ids, booked) != nil {
+				continue
+			}
+
+			blockX, blockY := sumX/float64(partySize), sumY/float64(partySize)
+			penalty := math.Abs(blockX-centreX)/halfWidth + math.Abs(blockY-idealY)/depth
+			if penalty < bestPenalty {
+				bestPenalty = penalty
+				best = append([]dto.SessionSeatResponse(nil), block...)
+			}
+		}
+	}
+
+	if best == nil {
+		return nil, 0
+	}
+
+	score := math.Max(0, 1-bestPenalty/2)
+	return best, math.Round(score*100) / 100
+}
+
+// contiguousFree reports whether every seat of the block is free and no aisle,
+// i.e. a hole in the seat numbering, runs through it.
+func contiguousFree(block []dto.SessionSeatResponse, free func(dto.SessionSeatResponse) bool) bool {
+	for i, seat := range block {
+		if !free(seat) {
+			return false
+		}
+		if i > 0 && seat.Number != block[i-1].Number+1 {
+			return false
+		}
+	}
+	return true
+}
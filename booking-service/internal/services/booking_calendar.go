@@ -0,0 +1,29 @@
+package services
+
+import (
+	"booking-service/internal/clients"
+	"booking-service/internal/config"
+	"booking-service/internal/constants"
+	"booking-service/internal/models"
+)
+
+// Calendar returns the booking as an .ics attachment with the movie title,
+// the venue and hall, and the booked seats. cinema-service renders it, since
+// it owns the calendar feeds and knows the session and its seats. Cancelled and
+// expired bookings are kept as cancelled events so calendars remove them.
+func (s *bookingService) Calendar(booking *models.Booking) ([]byte, error) {
+	seatIDs := make([]uint, 0, len(booking.BookedSeats))
+	for _, seat := range booking.BookedSeats {
+		seatIDs = append(seatIDs, seat.SeatID)
+	}
+	cancelled := booking.BookingStatus == constants.Cancelled ||
+		booking.BookingStatus == constants.Expired
+
+	calendar, err := clients.GetBookingCalendar(booking.SessionID, booking.ID, seatIDs, cancelled)
+	if err != nil {
+		config.GetLogger().Error("Failed to get booking calendar", "error", err, "booking_id", booking.ID, "session_id", booking.SessionID)
+		return nil, err
+	}
+
+	return calendar, nil
+}
@@ -1,14 +1,19 @@
 package services
 
 import (
+	"booking-service/internal/auth"
 	"booking-service/internal/clients"
 	"booking-service/internal/config"
 	"booking-service/internal/constants"
 	"booking-service/internal/dto"
+	"booking-service/internal/infrastructure"
 	"booking-service/internal/models"
 	"booking-service/internal/repository"
+	"cmp"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
@@ -21,28 +26,79 @@ type BookingService interface {
 	Update(id uint, req dto.BookingUpdateRequest) (*models.Booking, error)
 	Delete(id uint) error
 
-	ConfirmBooking(id uint) (*models.Booking, error)
+	ConfirmBooking(id uint, req dto.BookingConfirmRequest) (*models.Booking, error)
 	CancelBooking(id uint) (*models.Booking, error)
 	ExpireOldBookings() error
 	FreeSeatsForEndedSessions() error
 	ExpireBooking(id uint) (*models.Booking, error)
+
+	ListByUser(userID uint) ([]models.Booking, error)
+	ListBookedSeats(sessionID uint) ([]uint, error)
+	ListActiveBookings(sessionIDs, seatIDs []uint) ([]models.Booking, error)
+	RevokeBookings(event dto.BookingsRevokedEvent) error
+	BestSeats(req dto.BestSeatsRequest) (*dto.BestSeatsResponse, error)
+	Calendar(booking *models.Booking) ([]byte, error)
+	GetGuestBooking(id uint, token string) (*models.Booking, error)
+	ConfirmGuestBooking(id uint, token string, req dto.BookingConfirmRequest) (*models.Booking, error)
+	CancelGuestBooking(id uint, token string) (*models.Booking, error)
+	AttachGuestBookings(userID uint, req dto.AttachGuestBookingsRequest) (int64, error)
+	AttachGuestBookingsByEmail(email string, userID uint) (int64, error)
 }
 
 type bookingService struct {
 	bookingRepo     repository.BookingRepository
 	bookingSeatRepo repository.BookingSeatRepository
+	bookingItemRepo repository.BookingItemRepository
+	productRepo     repository.ProductRepository
+	voucherRepo     repository.VoucherRepository
 	db              *gorm.DB
 }
 
-func NewBookingService(bookingRepo repository.BookingRepository, bookingSeatRepo repository.BookingSeatRepository, db *gorm.DB) BookingService {
+func NewBookingService(
+	bookingRepo repository.BookingRepository,
+	bookingSeatRepo repository.BookingSeatRepository,
+	bookingItemRepo repository.BookingItemRepository,
+	productRepo repository.ProductRepository,
+	voucherRepo repository.VoucherRepository,
+	db *gorm.DB,
+) BookingService {
 	return &bookingService{
 		bookingRepo:     bookingRepo,
 		bookingSeatRepo: bookingSeatRepo,
+		bookingItemRepo: bookingItemRepo,
+		productRepo:     productRepo,
+		voucherRepo:     voucherRepo,
 		db:              db,
 	}
 }
 
 func (s *bookingService) Create(req dto.BookingCreateRequest) (*models.Booking, error) {
+	if req.UserID == 0 {
+		req.GuestEmail = strings.ToLower(strings.TrimSpace(req.GuestEmail))
+		req.GuestPhone = strings.TrimSpace(req.GuestPhone)
+		if req.GuestEmail == "" || req.GuestPhone == "" {
+			return nil, constants.ErrGuestContactRequired
+		}
+	} else {
+		req.GuestEmail = ""
+		req.GuestPhone = ""
+	}
+
+	selections, err := seatSelections(req)
+	if err != nil {
+		return nil, err
+	}
+
+	seatIDs := make([]uint, 0, len(selections))
+	for _, selection := range selections {
+		seatIDs = append(seatIDs, selection.SeatID)
+	}
+
+	items, itemsTotal, err := priceItems(s.productRepo, req.Items)
+	if err != nil {
+		return nil, err
+	}
+
 	tx := s.db.Begin()
 	if tx.Error != nil {
 		return nil, tx.Error
@@ -64,13 +120,35 @@ func (s *bookingService) Create(req dto.BookingCreateRequest) (*models.Booking,
 
 	if !session.StartTime.After(time.Now()) {
 		tx.Rollback()
-		return nil, fmt.Errorf("session already started")
+		return nil, constants.ErrSessionAlreadyStarted
 	}
 
-	bookedSeats, err := s.bookingRepo.CheckBooked(req.SessionID, req.SeatsID)
+	if err := checkSalesWindow(session, req.UserID, time.Now()); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	movie, err := clients.GetMovie(session.MovieID)
 	if err != nil {
 		tx.Rollback()
-		config.GetLogger().Error("Failed to check booked seats", "error", err, "session_id", req.SessionID, "seats", req.SeatsID)
+		config.GetLogger().Error("Failed to get movie", "error", err, "movie_id", session.MovieID)
+		return nil, fmt.Errorf("movie not found")
+	}
+
+	if err := checkAgeAllowed(movie, req, session.StartTime); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := checkCategoriesAllowed(movie, selections); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	bookedSeats, err := s.bookingRepo.CheckBooked(req.SessionID, seatIDs)
+	if err != nil {
+		tx.Rollback()
+		config.GetLogger().Error("Failed to check booked seats", "error", err, "session_id", req.SessionID, "seats", seatIDs)
 		return nil, err
 	}
 	if len(bookedSeats) > 0 {
@@ -78,12 +156,72 @@ func (s *bookingService) Create(req dto.BookingCreateRequest) (*models.Booking,
 		return nil, fmt.Errorf("seats already booked: %v", bookedSeats)
 	}
 
+	seatMap, err := clients.GetSessionSeats(req.SessionID)
+	if err != nil {
+		tx.Rollback()
+		config.GetLogger().Error("Failed to get session seats", "error", err, "session_id", req.SessionID)
+		return nil, err
+	}
+
+	sessionSeats := make(map[uint]dto.SessionSeatResponse, len(seatMap))
+	blockedSeats := make(map[uint]bool)
+	for _, seat := range seatMap {
+		sessionSeats[seat.ID] = seat
+		if seat.Blocked {
+			blockedSeats[seat.ID] = true
+		}
+	}
+
+	sessionBookedIDs, err := s.bookingRepo.ListBookedSeatIDs(req.SessionID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	sessionBooked := make(map[uint]bool, len(sessionBookedIDs))
+	for _, id := range sessionBookedIDs {
+		sessionBooked[id] = true
+	}
+
+	if err := checkSeatGroups(seatMap, seatIDs, sessionBooked); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	var seats = make([]models.BookedSeat, 0, len(selections))
+	var totalPrice int
+
+	for _, selection := range selections {
+		seat, ok := sessionSeats[selection.SeatID]
+		if !ok {
+			tx.Rollback()
+			return nil, fmt.Errorf("%w: %d", constants.ErrSeatNotInHall, selection.SeatID)
+		}
+		if blockedSeats[selection.SeatID] {
+			tx.Rollback()
+			return nil, fmt.Errorf("%w: %d", constants.ErrSeatBlocked, selection.SeatID)
+		}
+		price, ok := seat.CategoryPrices[selection.Category]
+		if !ok {
+			tx.Rollback()
+			return nil, fmt.Errorf("%w: %s", constants.ErrInvalidTicketCategory, selection.Category)
+		}
+		seats = append(seats, models.BookedSeat{SeatID: selection.SeatID, Category: selection.Category, Price: price})
+		totalPrice += price
+	}
+	totalPrice += itemsTotal
+
 	var booking = models.Booking{
 		SessionID:        req.SessionID,
+		MovieID:          session.MovieID,
+		HallID:           session.HallID,
 		UserID:           req.UserID,
+		GuestEmail:       req.GuestEmail,
+		GuestPhone:       req.GuestPhone,
 		BookingStatus:    constants.Pending,
 		PaymentStatus:    constants.PaymentPending,
 		ExpiresAt:        time.Now().Add(constants.BookingTimeoutMinutes * time.Minute),
+		SeatsCount:       len(seats),
+		TotalPrice:       totalPrice,
 		SessionStartTime: session.StartTime,
 		SessionEndTime:   session.EndTime,
 	}
@@ -95,10 +233,15 @@ func (s *bookingService) Create(req dto.BookingCreateRequest) (*models.Booking,
 		return nil, err
 	}
 
-	err = s.bookingSeatRepo.Create(tx, newBooking.ID, req.SeatsID)
+	err = s.bookingSeatRepo.Create(tx, newBooking.ID, seats)
 	if err != nil {
 		tx.Rollback()
-		config.GetLogger().Error("Failed to create booked seats", "error", err, "booking_id", newBooking.ID, "seats", req.SeatsID)
+		config.GetLogger().Error("Failed to create booked seats", "error", err, "booking_id", newBooking.ID, "seats", seatIDs)
+		return nil, err
+	}
+
+	if err := s.bookingItemRepo.Create(tx, newBooking.ID, items); err != nil {
+		tx.Rollback()
 		return nil, err
 	}
 
@@ -135,6 +278,139 @@ func (s *bookingService) GetByID(id uint) (*models.Booking, error) {
 	return booking, nil
 }
 
+func (s *bookingService) ListByUser(userID uint) ([]models.Booking, error) {
+	list, err := s.bookingRepo.ListByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (s *bookingService) ListBookedSeats(sessionID uint) ([]uint, error) {
+	seatIDs, err := s.bookingRepo.ListBookedSeatIDs(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return seatIDs, nil
+}
+
+func (s *bookingService) ListActiveBookings(sessionIDs, seatIDs []uint) ([]models.Booking, error) {
+	return s.bookingRepo.ListActive(sessionIDs, seatIDs)
+}
+
+// RevokeBookings cancels and refunds every active booking affected by a hall,
+// seat or session that was force-deleted in cinema-service. It fails when any
+// booking could not be revoked; running it again only picks up the bookings
+// that are still active.
+func (s *bookingService) RevokeBookings(event dto.BookingsRevokedEvent) error {
+	bookings, err := s.bookingRepo.ListActive(event.SessionIDs, event.SeatIDs)
+	if err != nil {
+		return err
+	}
+
+	config.GetLogger().Info("Revoking bookings",
+		"reason", event.Reason, "session_ids", event.SessionIDs, "seat_ids", event.SeatIDs, "count", len(bookings))
+
+	var failed []error
+	for _, booking := range bookings {
+		cancelled, err := s.cancelBooking(booking.ID, true)
+		if errors.Is(err, constants.ErrBookingAlreadyCancelled) || errors.Is(err, constants.ErrBookingExpired) {
+			continue
+		}
+		if err != nil {
+			config.GetLogger().Error("Failed to revoke booking",
+				"error", err, "booking_id", booking.ID, "reason", event.Reason)
+			failed = append(failed, fmt.Errorf("booking %d: %w", booking.ID, err))
+			continue
+		}
+
+		if err := infrastructure.PublishOrderCreated(*cancelled); err != nil {
+			config.GetLogger().Error("Failed to publish revoke event to Kafka",
+				"error", err, "booking_id", cancelled.ID)
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to revoke %d of %d bookings: %w", len(failed), len(bookings), errors.Join(failed...))
+	}
+	return nil
+}
+
+func (s *bookingService) GetGuestBooking(id uint, token string) (*models.Booking, error) {
+	booking, err := s.bookingRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if booking.UserID != 0 || !auth.VerifyGuestToken(booking.ID, booking.GuestEmail, token) {
+		return nil, constants.ErrInvalidGuestToken
+	}
+
+	return booking, nil
+}
+
+func (s *bookingService) ConfirmGuestBooking(id uint, token string, req dto.BookingConfirmRequest) (*models.Booking, error) {
+	if _, err := s.GetGuestBooking(id, token); err != nil {
+		return nil, err
+	}
+
+	return s.ConfirmBooking(id, req)
+}
+
+func (s *bookingService) CancelGuestBooking(id uint, token string) (*models.Booking, error) {
+	if _, err := s.GetGuestBooking(id, token); err != nil {
+		return nil, err
+	}
+
+	return s.CancelBooking(id)
+}
+
+// AttachGuestBookings moves the claimed guest bookings to the user. Nothing is
+// attached unless every guest token checks out.
+func (s *bookingService) AttachGuestBookings(userID uint, req dto.AttachGuestBookingsRequest) (int64, error) {
+	ids := make([]uint, 0, len(req.Bookings))
+	for _, claim := range req.Bookings {
+		if _, err := s.GetGuestBooking(claim.BookingID, claim.GuestToken); err != nil {
+			return 0, err
+		}
+		ids = append(ids, claim.BookingID)
+	}
+
+	attached, err := s.bookingRepo.AttachGuestBookings(ids, userID)
+	if err != nil {
+		config.GetLogger().Error("Failed to attach guest bookings", "error", err, "user_id", userID)
+		return 0, err
+	}
+
+	if attached > 0 {
+		config.GetLogger().Info("Guest bookings attached to user", "user_id", userID, "count", attached)
+	}
+
+	return attached, nil
+}
+
+// AttachGuestBookingsByEmail moves every guest booking made with the email to
+// the user who registered it.
+func (s *bookingService) AttachGuestBookingsByEmail(email string, userID uint) (int64, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" {
+		return 0, nil
+	}
+
+	attached, err := s.bookingRepo.AttachGuestBookingsByEmail(email, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	if attached > 0 {
+		config.GetLogger().Info("Guest bookings attached to new user", "user_id", userID, "count", attached)
+	}
+
+	return attached, nil
+}
+
 func (s *bookingService) Update(id uint, req dto.BookingUpdateRequest) (*models.Booking, error) {
 	booking, err := s.bookingRepo.GetByID(id)
 	if err != nil {
@@ -163,7 +439,7 @@ func (s *bookingService) Delete(id uint) error {
 	return nil
 }
 
-func (s *bookingService) ConfirmBooking(id uint) (*models.Booking, error) {
+func (s *bookingService) ConfirmBooking(id uint, req dto.BookingConfirmRequest) (*models.Booking, error) {
 	tx := s.db.Begin()
 	if tx.Error != nil {
 		return nil, tx.Error
@@ -203,6 +479,18 @@ func (s *bookingService) ConfirmBooking(id uint) (*models.Booking, error) {
 		tx.Rollback()
 		return nil, constants.ErrBookingAlreadyConfirmed
 	case constants.Pending:
+		if err := s.takeStock(tx, booking.Items); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		if req.VoucherCode != "" {
+			if err := redeemVoucher(s.voucherRepo, tx, req.VoucherCode, booking); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		}
+
 		booking.BookingStatus = constants.Confirmed
 		booking.PaymentStatus = constants.PaymentPaid
 		err = s.bookingRepo.UpdateWithTx(tx, booking.ID, *booking)
@@ -223,6 +511,13 @@ func (s *bookingService) ConfirmBooking(id uint) (*models.Booking, error) {
 }
 
 func (s *bookingService) CancelBooking(id uint) (*models.Booking, error) {
+	return s.cancelBooking(id, false)
+}
+
+// cancelBooking cancels the booking and refunds it if it was paid. Revoked
+// bookings are cancelled by the cinema, so they are refunded even after the
+// session has started.
+func (s *bookingService) cancelBooking(id uint, revoked bool) (*models.Booking, error) {
 	tx := s.db.Begin()
 	if tx.Error != nil {
 		return nil, tx.Error
@@ -254,6 +549,21 @@ func (s *bookingService) CancelBooking(id uint) (*models.Booking, error) {
 		return nil, constants.ErrBookingAlreadyCancelled
 	case constants.Pending:
 		booking.BookingStatus = constants.Cancelled
+	case constants.Confirmed:
+		if !revoked && !booking.SessionStartTime.After(time.Now()) {
+			tx.Rollback()
+			return nil, constants.ErrSessionAlreadyStarted
+		}
+		if err := s.restoreStock(tx, booking); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if err := refundVoucher(s.voucherRepo, tx, booking); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		booking.BookingStatus = constants.Cancelled
+		booking.PaymentStatus = constants.PaymentRefunded
 	default:
 		tx.Rollback()
 		return nil, constants.ErrInvalidBookingStatus
@@ -343,13 +653,18 @@ func (s *bookingService) ExpireOldBookings() error {
 	config.GetLogger().Info("Found expired bookings to expire", "count", len(expiredBookings))
 
 	for _, booking := range expiredBookings {
-		_, err := s.ExpireBooking(booking.ID)
+		expired, err := s.ExpireBooking(booking.ID)
 		if err != nil {
 			config.GetLogger().Error("Failed to expire booking",
 				"error", err, "booking_id", booking.ID)
 			continue
 		}
 
+		if err := infrastructure.PublishOrderCreated(*expired); err != nil {
+			config.GetLogger().Error("Failed to publish expire event to Kafka",
+				"error", err, "booking_id", expired.ID)
+		}
+
 		config.GetLogger().Info("Expired booking processed and seats freed",
 			"booking_id", booking.ID, "session_id", booking.SessionID)
 	}
@@ -429,3 +744,48 @@ func (s *bookingService) FreeSeatsForEndedSessions() error {
 
 	return nil
 }
+
+// takeStock decrements the add-ons of a booking being confirmed. Each product
+// row is locked before its stock is checked, in product order so concurrent
+// confirmations cannot oversell or deadlock.
+func (s *bookingService) takeStock(tx *gorm.DB, items []models.BookingItem) error {
+	items = slices.Clone(items)
+	slices.SortFunc(items, func(a, b models.BookingItem) int {
+		return cmp.Compare(a.ProductID, b.ProductID)
+	})
+
+	for _, item := range items {
+		product, err := s.productRepo.GetByIDForUpdate(tx, item.ProductID)
+		if err != nil {
+			if errors.Is(err, constants.ErrProductNotFound) {
+				return fmt.Errorf("%w: %s", constants.ErrProductUnavailable, item.Name)
+			}
+			return err
+		}
+		if product.Stock < item.Quantity {
+			return fmt.Errorf("%w: %s", constants.ErrInsufficientStock, item.Name)
+		}
+
+		if err := s.productRepo.DecrementStock(tx, item.ProductID, item.Quantity); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restoreStock returns the add-ons of a confirmed booking to the catalog.
+// Pending bookings never took any stock.
+func (s *bookingService) restoreStock(tx *gorm.DB, booking *models.Booking) error {
+	if booking.BookingStatus != constants.Confirmed {
+		return nil
+	}
+
+	for _, item := range booking.Items {
+		if err := s.productRepo.IncrementStock(tx, item.ProductID, item.Quantity); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package services
+
+import (
+	"booking-service/internal/config"
+	"booking-service/internal/constants"
+	"booking-service/internal/dto"
+	"booking-service/internal/models"
+	"booking-service/internal/repository"
+	"fmt"
+)
+
+type ProductService interface {
+	Create(req dto.ProductCreateRequest) (*models.Product, error)
+	List(activeOnly bool) ([]models.Product, error)
+	GetByID(id uint) (*models.Product, error)
+	Update(id uint, req dto.ProductUpdateRequest) (*models.Product, error)
+	Delete(id uint) error
+}
+
+type productService struct {
+	productRepo repository.ProductRepository
+}
+
+func NewProductService(productRepo repository.ProductRepository) ProductService {
+	return &productService{
+		productRepo: productRepo,
+	}
+}
+
+func (s *productService) Create(req dto.ProductCreateRequest) (*models.Product, error) {
+	product := models.Product{
+		Name:   req.Name,
+		Price:  req.Price,
+		Stock:  req.Stock,
+		Active: true,
+	}
+	if req.Active != nil {
+		product.Active = *req.Active
+	}
+
+	if err := s.productRepo.Create(&product); err != nil {
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+func (s *productService) List(activeOnly bool) ([]models.Product, error) {
+	return s.productRepo.List(activeOnly)
+}
+
+func (s *productService) GetByID(id uint) (*models.Product, error) {
+	return s.productRepo.GetByID(id)
+}
+
+func (s *productService) Update(id uint, req dto.ProductUpdateRequest) (*models.Product, error) {
+	product, err := s.productRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		product.Name = *req.Name
+	}
+	if req.Price != nil {
+		product.Price = *req.Price
+	}
+	if req.Stock != nil {
+		product.Stock = *req.Stock
+	}
+	if req.Active != nil {
+		product.Active = *req.Active
+	}
+
+	if err := s.productRepo.Update(product); err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+func (s *productService) Delete(id uint) error {
+	if _, err := s.productRepo.GetByID(id); err != nil {
+		return err
+	}
+
+	return s.productRepo.Delete(id)
+}
+
+// priceItems resolves the requested add-ons against the catalog. The same
+// product requested twice becomes one item. Stock is only checked here; it is
+// decremented by takeStock when the booking is confirmed.
+func priceItems(productRepo repository.ProductRepository, requests []dto.BookingItemRequest) ([]models.BookingItem, int, error) {
+	items := make([]models.BookingItem, 0, len(requests))
+	var total int
+
+	for _, req := range mergeItemRequests(requests) {
+		product, err := productRepo.GetByID(req.ProductID)
+		if err != nil {
+			config.GetLogger().Warn("Failed to get product for booking item", "error", err, "product_id", req.ProductID)
+			return nil, 0, fmt.Errorf("%w: %d", constants.ErrProductNotFound, req.ProductID)
+		}
+		if !product.Active {
+			return nil, 0, fmt.Errorf("%w: %s", constants.ErrProductUnavailable, product.Name)
+		}
+		if product.Stock < req.Quantity {
+			return nil, 0, fmt.Errorf("%w: %s", constants.ErrInsufficientStock, product.Name)
+		}
+
+		itemTotal := product.Price * req.Quantity
+		items = append(items, models.BookingItem{
+			ProductID:  product.ID,
+			Name:       product.Name,
+			Quantity:   req.Quantity,
+			UnitPrice:  product.Price,
+			TotalPrice: itemTotal,
+		})
+		total += itemTotal
+	}
+
+	return items, total, nil
+}
+
+func mergeItemRequests(requests []dto.BookingItemRequest) []dto.BookingItemRequest {
+	merged := make([]dto.BookingItemRequest, 0, len(requests))
+	positions := make(map[uint]int, len(requests))
+
+	for _, req := range requests {
+		if i, ok := positions[req.ProductID]; ok {
+			merged[i].Quantity += req.Quantity
+			continue
+		}
+		positions[req.ProductID] = len(merged)
+		merged = append(merged, req)
+	}
+
+	return merged
+}
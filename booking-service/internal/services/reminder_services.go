@@ -0,0 +1,97 @@
+package services
+
+import (
+	"booking-service/internal/clients"
+	"booking-service/internal/config"
+	"booking-service/internal/constants"
+	"booking-service/internal/infrastructure"
+	"booking-service/internal/models"
+	"booking-service/internal/repository"
+	"errors"
+)
+
+type ReminderService interface {
+	SendSessionReminders() error
+	SendHoldWarnings() error
+}
+
+type reminderService struct {
+	bookingRepo repository.BookingRepository
+}
+
+func NewReminderService(bookingRepo repository.BookingRepository) ReminderService {
+	return &reminderService{
+		bookingRepo: bookingRepo,
+	}
+}
+
+func (s *reminderService) SendSessionReminders() error {
+	bookings, err := s.bookingRepo.FindBookingsForReminder(config.ReminderBefore())
+	if err != nil {
+		return err
+	}
+
+	for _, booking := range bookings {
+		s.notify(booking, constants.EventReminder, s.bookingRepo.MarkReminderSent)
+	}
+
+	return nil
+}
+
+func (s *reminderService) SendHoldWarnings() error {
+	bookings, err := s.bookingRepo.FindPendingBookingsNearExpiry(config.HoldWarningBefore())
+	if err != nil {
+		return err
+	}
+
+	for _, booking := range bookings {
+		s.notify(booking, constants.EventHoldExpiring, s.bookingRepo.MarkHoldWarningSent)
+	}
+
+	return nil
+}
+
+func (s *reminderService) notify(booking models.Booking, event string, markSent func(id uint) error) {
+	logger := config.GetLogger()
+
+	if booking.UserID != 0 {
+		prefs, err := clients.GetNotificationPreferences(booking.UserID)
+		if errors.Is(err, constants.ErrUserNotFound) {
+			// The account is gone, asking again on every tick will not bring it back.
+			logger.Warn("User of booking no longer exists, skipping notification",
+				"booking_id", booking.ID, "user_id", booking.UserID, "event", event)
+			if err := markSent(booking.ID); err != nil {
+				logger.Error("Failed to mark skipped notification", "error", err, "booking_id", booking.ID)
+			}
+			return
+		}
+		if err != nil {
+			logger.Error("Failed to get notification preferences, will retry",
+				"error", err, "booking_id", booking.ID, "user_id", booking.UserID)
+			return
+		}
+
+		enabled := prefs.SessionReminders
+		if event == constants.EventHoldExpiring {
+			enabled = prefs.HoldWarnings
+		}
+
+		if !enabled {
+			logger.Info("User opted out of notification, skipping",
+				"booking_id", booking.ID, "user_id", booking.UserID, "event", event)
+			if err := markSent(booking.ID); err != nil {
+				logger.Error("Failed to mark skipped notification", "error", err, "booking_id", booking.ID)
+			}
+			return
+		}
+	}
+
+	if err := infrastructure.PublishBookingEvent(booking, event); err != nil {
+		logger.Error("Failed to publish reminder event", "error", err, "booking_id", booking.ID, "event", event)
+		return
+	}
+
+	if err := markSent(booking.ID); err != nil {
+		logger.Error("Failed to mark notification sent", "error", err, "booking_id", booking.ID, "event", event)
+	}
+}
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"booking-service/internal/clients"
+	"booking-service/internal/config"
+	"booking-service/internal/constants"
+	"booking-service/internal/dto"
+	"booking-service/internal/repository"
+	"math"
+)
+
+type ReportService interface {
+	Sales(filter dto.ReportFilter) ([]dto.SalesReportRow, error)
+	Occupancy(filter dto.ReportFilter) ([]dto.OccupancyReportRow, error)
+	Cancellations(filter dto.ReportFilter) (*dto.CancellationReport, error)
+}
+
+type reportService struct {
+	reportRepo repository.ReportRepository
+}
+
+func NewReportService(reportRepo repository.ReportRepository) ReportService {
+	return &reportService{
+		reportRepo: reportRepo,
+	}
+}
+
+func (s *reportService) Sales(filter dto.ReportFilter) ([]dto.SalesReportRow, error) {
+	if err := scopeToCinema(&filter); err != nil {
+		return nil, err
+	}
+	if filter.GroupBy == "" {
+		filter.GroupBy = "session"
+	}
+
+	rows, err := s.reportRepo.Sales(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func (s *reportService) Occupancy(filter dto.ReportFilter) ([]dto.OccupancyReportRow, error) {
+	if err := scopeToCinema(&filter); err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.reportRepo.SessionSales(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	hallIDs := make([]uint, 0)
+	seen := make(map[uint]bool)
+	for _, session := range sessions {
+		if !seen[session.HallID] {
+			seen[session.HallID] = true
+			hallIDs = append(hallIDs, session.HallID)
+		}
+	}
+
+	halls, err := clients.GetHalls(hallIDs)
+	if err != nil {
+		config.GetLogger().Error("Failed to get hall capacities", "error", err, "hall_ids", hallIDs)
+		return nil, err
+	}
+
+	rows := make([]dto.OccupancyReportRow, 0, len(sessions))
+
+	for _, session := range sessions {
+		capacity := len(halls[session.HallID].Seats)
+
+		row := dto.OccupancyReportRow{
+			SessionSalesRow: session,
+			Capacity:        capacity,
+		}
+		if capacity > 0 {
+			row.OccupancyPercent = percent(session.TicketsSold, int64(capacity))
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func (s *reportService) Cancellations(filter dto.ReportFilter) (*dto.CancellationReport, error) {
+	if err := scopeToCinema(&filter); err != nil {
+		return nil, err
+	}
+
+	counts, err := s.reportRepo.CountByStatus(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var report dto.CancellationReport
+
+	for _, row := range counts {
+		report.TotalBookings += row.Count
+
+		switch constants.BookingStatus(row.BookingStatus) {
+		case constants.Confirmed, constants.Finished:
+			report.Confirmed += row.Count
+		case constants.Cancelled:
+			report.Cancelled += row.Count
+		case constants.Expired:
+			report.Expired += row.Count
+		}
+	}
+
+	if report.TotalBookings > 0 {
+		report.CancellationRate = percent(report.Cancelled, report.TotalBookings)
+		report.ExpiryRate = percent(report.Expired, report.TotalBookings)
+	}
+
+	return &report, nil
+}
+
+// scopeToCinema resolves the halls of the requested venue, since bookings only
+// store the hall they were made for.
+func scopeToCinema(filter *dto.ReportFilter) error {
+	if filter.CinemaID == 0 {
+		return nil
+	}
+
+	cinema, err := clients.GetCinema(filter.CinemaID)
+	if err != nil {
+		config.GetLogger().Error("Failed to get cinema halls", "error", err, "cinema_id", filter.CinemaID)
+		return err
+	}
+
+	filter.HallIDs = make([]uint, 0, len(cinema.Halls))
+	for _, hall := range cinema.Halls {
+		filter.HallIDs = append(filter.HallIDs, hall.ID)
+	}
+
+	return nil
+}
+
+func percent(part, total int64) float64 {
+	return math.Round(float64(part)/float64(total)*10000) / 100
+}
@@ -0,0 +1,29 @@
+package services
+
+import (
+	"booking-service/internal/constants"
+	"booking-service/internal/dto"
+	"fmt"
+	"time"
+)
+
+// checkSalesWindow rejects bookings made before ticket sales open or after
+// they close. Registered users count as members and may already book during
+// the presale; guests wait until sales open to everyone.
+func checkSalesWindow(session *dto.SessionResponse, userID uint, now time.Time) error {
+	if session.SalesCloseAt != nil && !now.Before(*session.SalesCloseAt) {
+		return fmt.Errorf("%w: sales closed at %s", constants.ErrOutsideSalesWindow, session.SalesCloseAt.Format(time.RFC3339))
+	}
+	if session.SalesOpenAt == nil || !now.Before(*session.SalesOpenAt) {
+		return nil
+	}
+
+	if session.PresaleOpenAt != nil && !now.Before(*session.PresaleOpenAt) {
+		if userID != 0 {
+			return nil
+		}
+		return fmt.Errorf("%w: members-only presale until %s", constants.ErrOutsideSalesWindow, session.SalesOpenAt.Format(time.RFC3339))
+	}
+
+	return fmt.Errorf("%w: sales open at %s", constants.ErrOutsideSalesWindow, session.SalesOpenAt.Format(time.RFC3339))
+}
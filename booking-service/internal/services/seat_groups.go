@@ -0,0 +1,34 @@
+package services
+
+import (
+	"booking-service/internal/constants"
+	"booking-service/internal/dto"
+	"fmt"
+	"slices"
+)
+
+// checkSeatGroups makes sure seats of a group that is sold together, such as a
+// love seat, are either all booked or none of them is. Members that are
+// already booked or blocked cannot be sold anyway, so they do not hold the
+// rest of the group back.
+func checkSeatGroups(seatMap []dto.SessionSeatResponse, seatIDs []uint, booked map[uint]bool) error {
+	members := make(map[uint][]uint)
+	for _, seat := range seatMap {
+		if seat.GroupID != nil && seat.SellTogether && !seat.Blocked && !booked[seat.ID] {
+			members[*seat.GroupID] = append(members[*seat.GroupID], seat.ID)
+		}
+	}
+
+	for _, seat := range seatMap {
+		if seat.GroupID == nil || !seat.SellTogether || !slices.Contains(seatIDs, seat.ID) {
+			continue
+		}
+		for _, memberID := range members[*seat.GroupID] {
+			if !slices.Contains(seatIDs, memberID) {
+				return fmt.Errorf("%w: seat %d requires seat %d", constants.ErrIncompleteSeatGroup, seat.ID, memberID)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"booking-service/internal/constants"
+	"booking-service/internal/dto"
+	"fmt"
+	"slices"
+)
+
+// seatSelections merges the plain seats_id list and the per-seat selections
+// into one list. Seats listed without a category are sold as adult tickets.
+func seatSelections(req dto.BookingCreateRequest) ([]dto.BookingSeatRequest, error) {
+	selections := make([]dto.BookingSeatRequest, 0, len(req.SeatsID)+len(req.Seats))
+
+	for _, seatID := range req.SeatsID {
+		selections = append(selections, dto.BookingSeatRequest{SeatID: seatID, Category: constants.TicketAdult})
+	}
+
+	for _, seat := range req.Seats {
+		if seat.Category == "" {
+			seat.Category = constants.TicketAdult
+		}
+		if !slices.Contains(constants.TicketCategories, seat.Category) {
+			return nil, fmt.Errorf("%w: %s", constants.ErrInvalidTicketCategory, seat.Category)
+		}
+		selections = append(selections, seat)
+	}
+
+	if len(selections) == 0 {
+		return nil, constants.ErrNoSeatsSelected
+	}
+
+	seen := make(map[uint]bool, len(selections))
+	for _, selection := range selections {
+		if seen[selection.SeatID] {
+			return nil, fmt.Errorf("%w: %d", constants.ErrDuplicateSeat, selection.SeatID)
+		}
+		seen[selection.SeatID] = true
+	}
+
+	return selections, nil
+}
+
+func checkCategoriesAllowed(movie *dto.MovieResponse, selections []dto.BookingSeatRequest) error {
+	if movie.MinAge <= constants.ChildTicketMaxMinAge {
+		return nil
+	}
+
+	for _, selection := range selections {
+		if selection.Category == constants.TicketChild {
+			return fmt.Errorf("%w: %s tickets for %s rated movie", constants.ErrTicketCategoryNotAllowed, constants.TicketChild, movie.AgeRating)
+		}
+	}
+
+	return nil
+}
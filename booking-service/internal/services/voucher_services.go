@@ -0,0 +1,282 @@
+package services
+
+import (
+	"booking-service/internal/config"
+	"booking-service/internal/constants"
+	"booking-service/internal/dto"
+	"booking-service/internal/models"
+	"booking-service/internal/repository"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	voucherCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	voucherCodeGroups   = 3
+	voucherCodeGroupLen = 4
+)
+
+type VoucherService interface {
+	Issue(req dto.VoucherCreateRequest) (*models.Voucher, error)
+	Purchase(userID uint, req dto.VoucherPurchaseRequest) (*models.Voucher, error)
+	ListPurchased(userID uint) ([]models.Voucher, error)
+	GetByCode(code string) (*dto.VoucherResponse, error)
+	Balance(code string) (*dto.VoucherBalanceResponse, error)
+	Deactivate(code string) (*models.Voucher, error)
+}
+
+type voucherService struct {
+	voucherRepo repository.VoucherRepository
+	db          *gorm.DB
+}
+
+func NewVoucherService(voucherRepo repository.VoucherRepository, db *gorm.DB) VoucherService {
+	return &voucherService{
+		voucherRepo: voucherRepo,
+		db:          db,
+	}
+}
+
+func (s *voucherService) Issue(req dto.VoucherCreateRequest) (*models.Voucher, error) {
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, constants.ErrVoucherExpired
+	}
+
+	voucher := models.Voucher{
+		InitialBalance: req.Amount,
+		Balance:        req.Amount,
+		ExpiresAt:      req.ExpiresAt,
+		Active:         true,
+		PurchaserEmail: strings.ToLower(strings.TrimSpace(req.PurchaserEmail)),
+	}
+
+	if err := s.create(&voucher, constants.VoucherIssue); err != nil {
+		return nil, err
+	}
+
+	config.GetLogger().Info("Voucher issued", "voucher_id", voucher.ID, "amount", voucher.Balance)
+
+	return &voucher, nil
+}
+
+// Purchase sells a gift card to the user. Like a confirmed booking, the
+// payment is recorded as paid in the same transaction that issues the card.
+func (s *voucherService) Purchase(userID uint, req dto.VoucherPurchaseRequest) (*models.Voucher, error) {
+	expiresAt := time.Now().Add(constants.PurchasedVoucherValidity)
+	voucher := models.Voucher{
+		InitialBalance:  req.Amount,
+		Balance:         req.Amount,
+		ExpiresAt:       &expiresAt,
+		Active:          true,
+		PurchaserUserID: &userID,
+		PaymentStatus:   constants.PaymentPaid,
+	}
+
+	if err := s.create(&voucher, constants.VoucherPurchase); err != nil {
+		return nil, err
+	}
+
+	config.GetLogger().Info("Voucher purchased", "voucher_id", voucher.ID, "user_id", userID, "amount", voucher.Balance)
+
+	return &voucher, nil
+}
+
+func (s *voucherService) ListPurchased(userID uint) ([]models.Voucher, error) {
+	return s.voucherRepo.ListByPurchaser(userID)
+}
+
+// create stores the voucher under a new code together with the ledger entry
+// that opens its balance.
+func (s *voucherService) create(voucher *models.Voucher, txType constants.VoucherTransactionType) error {
+	code, err := generateVoucherCode()
+	if err != nil {
+		return err
+	}
+	voucher.Code = code
+
+	tx := s.db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := s.voucherRepo.Create(tx, voucher); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := s.voucherRepo.AddTransaction(tx, &models.VoucherTransaction{
+		VoucherID:    voucher.ID,
+		Type:         txType,
+		Amount:       voucher.Balance,
+		BalanceAfter: voucher.Balance,
+	}); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (s *voucherService) GetByCode(code string) (*dto.VoucherResponse, error) {
+	voucher, err := s.voucherRepo.GetByCode(normalizeVoucherCode(code))
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := s.voucherRepo.ListTransactions(voucher.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.VoucherResponse{
+		Voucher:      *voucher,
+		Transactions: transactions,
+	}, nil
+}
+
+func (s *voucherService) Balance(code string) (*dto.VoucherBalanceResponse, error) {
+	voucher, err := s.voucherRepo.GetByCode(normalizeVoucherCode(code))
+	if err != nil {
+		return nil, err
+	}
+
+	status := constants.VoucherStatusActive
+	switch {
+	case !voucher.Active:
+		status = constants.VoucherStatusInactive
+	case voucher.ExpiresAt != nil && !voucher.ExpiresAt.After(time.Now()):
+		status = constants.VoucherStatusExpired
+	case voucher.Balance <= 0:
+		status = constants.VoucherStatusEmpty
+	}
+
+	return &dto.VoucherBalanceResponse{
+		Code:      voucher.Code,
+		Balance:   voucher.Balance,
+		Status:    status,
+		ExpiresAt: voucher.ExpiresAt,
+	}, nil
+}
+
+func (s *voucherService) Deactivate(code string) (*models.Voucher, error) {
+	voucher, err := s.voucherRepo.GetByCode(normalizeVoucherCode(code))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.voucherRepo.Deactivate(voucher.ID); err != nil {
+		return nil, err
+	}
+	voucher.Active = false
+
+	return voucher, nil
+}
+
+// redeemVoucher charges as much of the booking total as the voucher balance
+// covers. The rest of the total is left to the regular payment.
+func redeemVoucher(voucherRepo repository.VoucherRepository, tx *gorm.DB, code string, booking *models.Booking) error {
+	voucher, err := voucherRepo.GetByCodeForUpdate(tx, normalizeVoucherCode(code))
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case !voucher.Active:
+		return constants.ErrVoucherInactive
+	case voucher.ExpiresAt != nil && !voucher.ExpiresAt.After(time.Now()):
+		return constants.ErrVoucherExpired
+	case voucher.Balance <= 0:
+		return constants.ErrVoucherEmpty
+	}
+
+	amount := min(voucher.Balance, booking.TotalPrice)
+	if amount == 0 {
+		return nil
+	}
+
+	balance := voucher.Balance - amount
+	if err := voucherRepo.UpdateBalance(tx, voucher.ID, balance); err != nil {
+		return err
+	}
+
+	bookingID := booking.ID
+	if err := voucherRepo.AddTransaction(tx, &models.VoucherTransaction{
+		VoucherID:    voucher.ID,
+		BookingID:    &bookingID,
+		Type:         constants.VoucherRedeem,
+		Amount:       -amount,
+		BalanceAfter: balance,
+	}); err != nil {
+		return err
+	}
+
+	booking.VoucherID = &voucher.ID
+	booking.VoucherAmount = amount
+
+	return nil
+}
+
+func refundVoucher(voucherRepo repository.VoucherRepository, tx *gorm.DB, booking *models.Booking) error {
+	if booking.VoucherID == nil || booking.VoucherAmount == 0 {
+		return nil
+	}
+
+	voucher, err := voucherRepo.GetByIDForUpdate(tx, *booking.VoucherID)
+	if err != nil {
+		return err
+	}
+
+	balance := voucher.Balance + booking.VoucherAmount
+	if err := voucherRepo.UpdateBalance(tx, voucher.ID, balance); err != nil {
+		return err
+	}
+
+	bookingID := booking.ID
+	return voucherRepo.AddTransaction(tx, &models.VoucherTransaction{
+		VoucherID:    voucher.ID,
+		BookingID:    &bookingID,
+		Type:         constants.VoucherRefund,
+		Amount:       booking.VoucherAmount,
+		BalanceAfter: balance,
+	})
+}
+
+func generateVoucherCode() (string, error) {
+	groups := make([]string, 0, voucherCodeGroups)
+	alphabetSize := big.NewInt(int64(len(voucherCodeAlphabet)))
+
+	for i := 0; i < voucherCodeGroups; i++ {
+		var group strings.Builder
+		for j := 0; j < voucherCodeGroupLen; j++ {
+			n, err := rand.Int(rand.Reader, alphabetSize)
+			if err != nil {
+				return "", err
+			}
+			group.WriteByte(voucherCodeAlphabet[n.Int64()])
+		}
+		groups = append(groups, group.String())
+	}
+
+	return strings.Join(groups, "-"), nil
+}
+
+func normalizeVoucherCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
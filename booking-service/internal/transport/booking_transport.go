@@ -1,14 +1,19 @@
 package transport
 
 import (
+	"booking-service/internal/auth"
 	"booking-service/internal/config"
 	"booking-service/internal/constants"
 	"booking-service/internal/dto"
 	"booking-service/internal/infrastructure"
+	"booking-service/internal/models"
 	"booking-service/internal/services"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -33,6 +38,21 @@ func (h *bookingTransport) BookingRoutes(ctx *gin.Engine) {
 		api.DELETE("/:id", h.Delete)
 		api.POST("/:id/confirm", h.ConfirmBooking)
 		api.POST("/:id/cancel", h.CancelBooking)
+		api.GET("/user/:id", h.ListByUser)
+		api.GET("/sessions/:id/booked-seats", h.ListBookedSeats)
+		api.GET("/active", h.ListActive)
+		api.POST("/best-seats", h.BestSeats)
+		api.GET("/:id/calendar.ics", h.Calendar)
+		api.POST("/attach-guest", h.AttachGuestBookings)
+	}
+
+	guest := ctx.Group("/guest/bookings")
+	{
+		guest.POST("", h.CreateGuest)
+		guest.GET("/:id", h.GetGuestBooking)
+		guest.GET("/:id/calendar.ics", h.GuestCalendar)
+		guest.POST("/:id/confirm", h.ConfirmGuestBooking)
+		guest.POST("/:id/cancel", h.CancelGuestBooking)
 	}
 }
 
@@ -45,10 +65,48 @@ func (h *bookingTransport) Create(ctx *gin.Context) {
 		return
 	}
 
+	// Age and presale checks trust user_id, so it has to be the caller's.
+	userID, err := auth.BearerUserID(ctx.GetHeader("Authorization"))
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	req.UserID = userID
+
+	h.create(ctx, req)
+}
+
+// CreateGuest books without an account. The caller is not authenticated, so
+// the request may not name a user and must carry the guest contact details.
+func (h *bookingTransport) CreateGuest(ctx *gin.Context) {
+	var req dto.BookingCreateRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		config.GetLogger().Warn("Invalid JSON in guest booking request", "error", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid JSON"})
+		return
+	}
+
+	if req.UserID != 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": constants.ErrGuestUserID.Error()})
+		return
+	}
+	if req.GuestEmail == "" || req.GuestPhone == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": constants.ErrGuestContactRequired.Error()})
+		return
+	}
+
+	h.create(ctx, req)
+}
+
+func (h *bookingTransport) create(ctx *gin.Context, req dto.BookingCreateRequest) {
 	config.GetLogger().Info("Creating booking", "session_id", req.SessionID, "user_id", req.UserID, "seats", req.SeatsID)
 
 	booking, err := h.service.Create(req)
 	if err != nil {
+		if respondCreateError(ctx, err) {
+			return
+		}
 		config.GetLogger().Error("Failed to create booking", "error", err, "session_id", req.SessionID, "user_id", req.UserID, "seats", req.SeatsID)
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -56,6 +114,14 @@ func (h *bookingTransport) Create(ctx *gin.Context) {
 
 	config.GetLogger().Info("Booking created successfully", "booking_id", booking.ID, "session_id", booking.SessionID, "user_id", booking.UserID)
 
+	if booking.UserID == 0 {
+		ctx.JSON(http.StatusOK, dto.GuestBookingResponse{
+			Booking:    *booking,
+			GuestToken: auth.GuestTokenFor(booking),
+		})
+		return
+	}
+
 	ctx.JSON(http.StatusOK, booking)
 }
 
@@ -151,13 +217,24 @@ func (h *bookingTransport) ConfirmBooking(ctx *gin.Context) {
 		return
 	}
 
-	confirmed, err := h.service.ConfirmBooking(uint(id))
+	req, ok := bindConfirmRequest(ctx)
+	if !ok {
+		return
+	}
+
+	confirmed, err := h.service.ConfirmBooking(uint(id), req)
 	if err != nil {
 		switch {
 
+		case isVoucherError(err):
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+
 		case errors.Is(err, constants.ErrBookingAlreadyCancelled),
 			errors.Is(err, constants.ErrBookingAlreadyConfirmed),
-			errors.Is(err, constants.ErrBookingExpired):
+			errors.Is(err, constants.ErrBookingExpired),
+			errors.Is(err, constants.ErrInsufficientStock),
+			errors.Is(err, constants.ErrProductUnavailable):
 			ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 			return
 
@@ -196,7 +273,8 @@ func (h *bookingTransport) CancelBooking(ctx *gin.Context) {
 		switch {
 
 		case errors.Is(err, constants.ErrBookingAlreadyCancelled),
-			errors.Is(err, constants.ErrBookingExpired):
+			errors.Is(err, constants.ErrBookingExpired),
+			errors.Is(err, constants.ErrSessionAlreadyStarted):
 			ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 			return
 
@@ -226,6 +304,220 @@ func (h *bookingTransport) CancelBooking(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, cancelled)
 }
 
+func (h *bookingTransport) ListByUser(ctx *gin.Context) {
+	userID, err := parseID(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	list, err := h.service.ListByUser(userID)
+	if err != nil {
+		config.GetLogger().Error("Failed to list user bookings", "error", err, "user_id", userID)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, list)
+}
+
+func (h *bookingTransport) ListBookedSeats(ctx *gin.Context) {
+	sessionID, err := parseID(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	seatIDs, err := h.service.ListBookedSeats(sessionID)
+	if err != nil {
+		config.GetLogger().Error("Failed to list booked seats", "error", err, "session_id", sessionID)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"seat_ids": seatIDs})
+}
+
+// ListActive reports the pending and confirmed bookings that hold any of the
+// given sessions or seats, so cinema-service can refuse to delete them and
+// notification-service can tell the holders about a cancelled session.
+func (h *bookingTransport) ListActive(ctx *gin.Context) {
+	sessionIDs, err := parseIDList(ctx.Query("session_ids"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid session_ids"})
+		return
+	}
+	seatIDs, err := parseIDList(ctx.Query("seat_ids"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid seat_ids"})
+		return
+	}
+	if len(sessionIDs) == 0 && len(seatIDs) == 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "session_ids or seat_ids is required"})
+		return
+	}
+
+	bookings, err := h.service.ListActiveBookings(sessionIDs, seatIDs)
+	if err != nil {
+		config.GetLogger().Error("Failed to list active bookings", "error", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	bookingIDs := make([]uint, 0, len(bookings))
+	active := make([]dto.ActiveBooking, 0, len(bookings))
+	for _, booking := range bookings {
+		bookingIDs = append(bookingIDs, booking.ID)
+		active = append(active, dto.ActiveBooking{
+			ID:         booking.ID,
+			SessionID:  booking.SessionID,
+			UserID:     booking.UserID,
+			GuestEmail: booking.GuestEmail,
+			SeatsCount: booking.SeatsCount,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, dto.ActiveBookingsResponse{Count: len(bookingIDs), BookingIDs: bookingIDs, Bookings: active})
+}
+
+func (h *bookingTransport) AttachGuestBookings(ctx *gin.Context) {
+	var req dto.AttachGuestBookingsRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		config.GetLogger().Warn("Invalid JSON in attach guest bookings request", "error", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid JSON"})
+		return
+	}
+
+	userID, err := auth.BearerUserID(ctx.GetHeader("Authorization"))
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	attached, err := h.service.AttachGuestBookings(userID, req)
+	if err != nil {
+		respondGuestError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"attached": attached})
+}
+
+func (h *bookingTransport) GetGuestBooking(ctx *gin.Context) {
+	id, err := parseID(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	booking, err := h.service.GetGuestBooking(id, guestToken(ctx))
+	if err != nil {
+		respondGuestError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, booking)
+}
+
+func (h *bookingTransport) ConfirmGuestBooking(ctx *gin.Context) {
+	id, err := parseID(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	req, ok := bindConfirmRequest(ctx)
+	if !ok {
+		return
+	}
+
+	confirmed, err := h.service.ConfirmGuestBooking(id, guestToken(ctx), req)
+	if err != nil {
+		respondGuestError(ctx, err)
+		return
+	}
+
+	if err := infrastructure.PublishOrderCreated(*confirmed); err != nil {
+		config.GetLogger().Error("Failed to publish event to Kafka",
+			"error", err,
+			"booking_id", confirmed.ID,
+			"session_id", confirmed.SessionID)
+	}
+
+	ctx.JSON(http.StatusOK, confirmed)
+}
+
+func (h *bookingTransport) CancelGuestBooking(ctx *gin.Context) {
+	id, err := parseID(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	cancelled, err := h.service.CancelGuestBooking(id, guestToken(ctx))
+	if err != nil {
+		respondGuestError(ctx, err)
+		return
+	}
+
+	if err := infrastructure.PublishOrderCreated(*cancelled); err != nil {
+		config.GetLogger().Error("Failed to publish cancel event to Kafka",
+			"error", err,
+			"booking_id", cancelled.ID,
+			"session_id", cancelled.SessionID)
+	}
+
+	ctx.JSON(http.StatusOK, cancelled)
+}
+
+func guestToken(ctx *gin.Context) string {
+	if token := ctx.GetHeader("X-Guest-Token"); token != "" {
+		return token
+	}
+	return ctx.Query("token")
+}
+
+func respondGuestError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, constants.ErrInvalidGuestToken):
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrBookingNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case isVoucherError(err):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrBookingAlreadyCancelled),
+		errors.Is(err, constants.ErrBookingAlreadyConfirmed),
+		errors.Is(err, constants.ErrBookingExpired),
+		errors.Is(err, constants.ErrInsufficientStock),
+		errors.Is(err, constants.ErrProductUnavailable),
+		errors.Is(err, constants.ErrSessionAlreadyStarted):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// bindConfirmRequest accepts an empty body so bookings can still be confirmed
+// without a voucher.
+func bindConfirmRequest(ctx *gin.Context) (dto.BookingConfirmRequest, bool) {
+	var req dto.BookingConfirmRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid JSON"})
+		return req, false
+	}
+
+	return req, true
+}
+
+func isVoucherError(err error) bool {
+	return errors.Is(err, constants.ErrVoucherNotFound) ||
+		errors.Is(err, constants.ErrVoucherInactive) ||
+		errors.Is(err, constants.ErrVoucherExpired) ||
+		errors.Is(err, constants.ErrVoucherEmpty)
+}
+
 func parseID(idStr string) (uint, error) {
 	id, err := strconv.ParseUint(idStr, 10, 64)
 	if err != nil {
@@ -233,3 +525,137 @@ func parseID(idStr string) (uint, error) {
 	}
 	return uint(id), nil
 }
+
+func parseIDList(value string) ([]uint, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(value, ",")
+	ids := make([]uint, 0, len(parts))
+	for _, part := range parts {
+		id, err := parseID(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// respondCreateError writes the response for the errors a new booking can be
+// rejected with and reports whether err was one of them.
+func respondCreateError(ctx *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, constants.ErrGuestContactRequired),
+		errors.Is(err, constants.ErrNoSeatsSelected),
+		errors.Is(err, constants.ErrDuplicateSeat),
+		errors.Is(err, constants.ErrInvalidTicketCategory),
+		errors.Is(err, constants.ErrBirthdateRequired),
+		errors.Is(err, constants.ErrProductNotFound),
+		errors.Is(err, constants.ErrProductUnavailable),
+		errors.Is(err, constants.ErrIncompleteSeatGroup):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrInsufficientStock),
+		errors.Is(err, constants.ErrSessionAlreadyStarted),
+		errors.Is(err, constants.ErrOutsideSalesWindow),
+		errors.Is(err, constants.ErrSeatBlocked):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrTicketCategoryNotAllowed):
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrAgeRestricted):
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		return false
+	}
+	return true
+}
+
+// BestSeats suggests the best free seats next to each other for a party and
+// can hold them straight away.
+func (h *bookingTransport) BestSeats(ctx *gin.Context) {
+	var req dto.BestSeatsRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		config.GetLogger().Warn("Invalid JSON in best seats request", "error", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if req.UserID != 0 {
+		userID, err := auth.BearerUserID(ctx.GetHeader("Authorization"))
+		if err != nil {
+			ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		req.UserID = userID
+	}
+
+	result, err := h.service.BestSeats(req)
+	if err != nil {
+		if errors.Is(err, constants.ErrNoContiguousSeats) {
+			ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		if respondCreateError(ctx, err) {
+			return
+		}
+		config.GetLogger().Error("Failed to pick best seats", "error", err, "session_id", req.SessionID, "party_size", req.PartySize)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if result.Booking != nil {
+		config.GetLogger().Info("Best seats held", "booking_id", result.Booking.ID, "session_id", req.SessionID, "party_size", req.PartySize)
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+func (h *bookingTransport) Calendar(ctx *gin.Context) {
+	id, err := parseID(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	booking, err := h.service.GetByID(id)
+	if err != nil {
+		if errors.Is(err, constants.ErrBookingNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.writeCalendar(ctx, booking)
+}
+
+func (h *bookingTransport) GuestCalendar(ctx *gin.Context) {
+	id, err := parseID(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	booking, err := h.service.GetGuestBooking(id, guestToken(ctx))
+	if err != nil {
+		respondGuestError(ctx, err)
+		return
+	}
+
+	h.writeCalendar(ctx, booking)
+}
+
+// writeCalendar sends the booking as an .ics attachment.
+func (h *bookingTransport) writeCalendar(ctx *gin.Context, booking *models.Booking) {
+	calendar, err := h.service.Calendar(booking)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{"error": "failed to load the booking calendar"})
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=booking-%d.ics", booking.ID))
+	ctx.Data(http.StatusOK, "text/calendar; charset=utf-8", calendar)
+}
@@ -0,0 +1,129 @@
+package transport
+
+import (
+	"booking-service/internal/config"
+	"booking-service/internal/constants"
+	"booking-service/internal/dto"
+	"booking-service/internal/middleware"
+	"booking-service/internal/services"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type productTransport struct {
+	service services.ProductService
+}
+
+func NewProductHandler(service services.ProductService) *productTransport {
+	return &productTransport{
+		service: service,
+	}
+}
+
+func (h *productTransport) ProductRoutes(ctx *gin.Engine) {
+	api := ctx.Group("/products")
+	{
+		api.GET("", h.List)
+		api.GET("/:id", h.GetByID)
+	}
+
+	admin := ctx.Group("/products", middleware.AdminOnly())
+	{
+		admin.POST("", h.Create)
+		admin.PATCH("/:id", h.Update)
+		admin.DELETE("/:id", h.Delete)
+	}
+}
+
+func (h *productTransport) Create(ctx *gin.Context) {
+	var req dto.ProductCreateRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	product, err := h.service.Create(req)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	config.GetLogger().Info("Product created", "product_id", product.ID, "name", product.Name)
+	ctx.JSON(http.StatusCreated, product)
+}
+
+func (h *productTransport) List(ctx *gin.Context) {
+	activeOnly := ctx.Query("active") == "true"
+
+	products, err := h.service.List(activeOnly)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, products)
+}
+
+func (h *productTransport) GetByID(ctx *gin.Context) {
+	id, err := parseID(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	product, err := h.service.GetByID(id)
+	if err != nil {
+		respondProductError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, product)
+}
+
+func (h *productTransport) Update(ctx *gin.Context) {
+	id, err := parseID(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req dto.ProductUpdateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	product, err := h.service.Update(id, req)
+	if err != nil {
+		respondProductError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, product)
+}
+
+func (h *productTransport) Delete(ctx *gin.Context) {
+	id, err := parseID(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	if err := h.service.Delete(id); err != nil {
+		respondProductError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "product deleted"})
+}
+
+func respondProductError(ctx *gin.Context, err error) {
+	if errors.Is(err, constants.ErrProductNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
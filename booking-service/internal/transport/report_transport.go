@@ -0,0 +1,201 @@
+package transport
+
+import (
+	"booking-service/internal/config"
+	"booking-service/internal/constants"
+	"booking-service/internal/dto"
+	"booking-service/internal/middleware"
+	"booking-service/internal/services"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const reportDateLayout = "2006-01-02"
+
+type reportTransport struct {
+	service services.ReportService
+}
+
+func NewReportHandler(service services.ReportService) *reportTransport {
+	return &reportTransport{
+		service: service,
+	}
+}
+
+func (h *reportTransport) ReportRoutes(ctx *gin.Engine) {
+	api := ctx.Group("/reports", middleware.AdminOnly())
+	{
+		api.GET("/sales", h.Sales)
+		api.GET("/occupancy", h.Occupancy)
+		api.GET("/cancellations", h.Cancellations)
+	}
+}
+
+func (h *reportTransport) Sales(ctx *gin.Context) {
+	query, filter, ok := bindReportQuery(ctx)
+	if !ok {
+		return
+	}
+
+	rows, err := h.service.Sales(filter)
+	if err != nil {
+		if errors.Is(err, constants.ErrInvalidReportFilter) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "group_by must be one of session, movie, hall, day"})
+			return
+		}
+		if errors.Is(err, constants.ErrCinemaNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		config.GetLogger().Error("Failed to build sales report", "error", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if query.Format == "csv" {
+		records := make([][]string, 0, len(rows))
+		for _, row := range rows {
+			records = append(records, []string{
+				row.Key,
+				strconv.FormatInt(row.Bookings, 10),
+				strconv.FormatInt(row.TicketsSold, 10),
+				strconv.FormatInt(row.Revenue, 10),
+			})
+		}
+		writeCSV(ctx, "sales.csv", []string{filter.GroupBy, "bookings", "tickets_sold", "revenue"}, records)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rows)
+}
+
+func (h *reportTransport) Occupancy(ctx *gin.Context) {
+	query, filter, ok := bindReportQuery(ctx)
+	if !ok {
+		return
+	}
+
+	rows, err := h.service.Occupancy(filter)
+	if err != nil {
+		if errors.Is(err, constants.ErrCinemaNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		config.GetLogger().Error("Failed to build occupancy report", "error", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if query.Format == "csv" {
+		records := make([][]string, 0, len(rows))
+		for _, row := range rows {
+			records = append(records, []string{
+				strconv.FormatUint(uint64(row.SessionID), 10),
+				strconv.FormatUint(uint64(row.MovieID), 10),
+				strconv.FormatUint(uint64(row.HallID), 10),
+				row.SessionStartTime.Format(time.RFC3339),
+				strconv.FormatInt(row.TicketsSold, 10),
+				strconv.Itoa(row.Capacity),
+				strconv.FormatFloat(row.OccupancyPercent, 'f', 2, 64),
+			})
+		}
+		writeCSV(ctx, "occupancy.csv", []string{"session_id", "movie_id", "hall_id", "session_start_time", "tickets_sold", "capacity", "occupancy_percent"}, records)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rows)
+}
+
+func (h *reportTransport) Cancellations(ctx *gin.Context) {
+	query, filter, ok := bindReportQuery(ctx)
+	if !ok {
+		return
+	}
+
+	report, err := h.service.Cancellations(filter)
+	if err != nil {
+		if errors.Is(err, constants.ErrCinemaNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		config.GetLogger().Error("Failed to build cancellations report", "error", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if query.Format == "csv" {
+		writeCSV(ctx, "cancellations.csv",
+			[]string{"total_bookings", "confirmed", "cancelled", "expired", "cancellation_rate", "expiry_rate"},
+			[][]string{{
+				strconv.FormatInt(report.TotalBookings, 10),
+				strconv.FormatInt(report.Confirmed, 10),
+				strconv.FormatInt(report.Cancelled, 10),
+				strconv.FormatInt(report.Expired, 10),
+				strconv.FormatFloat(report.CancellationRate, 'f', 2, 64),
+				strconv.FormatFloat(report.ExpiryRate, 'f', 2, 64),
+			}})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
+
+func bindReportQuery(ctx *gin.Context) (dto.ReportQuery, dto.ReportFilter, bool) {
+	var query dto.ReportQuery
+	var filter dto.ReportFilter
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid query"})
+		return query, filter, false
+	}
+
+	if query.Format != "" && query.Format != "json" && query.Format != "csv" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "format must be json or csv"})
+		return query, filter, false
+	}
+
+	if query.From != "" {
+		from, err := time.Parse(reportDateLayout, query.From)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "from must be in YYYY-MM-DD format"})
+			return query, filter, false
+		}
+		filter.From = &from
+	}
+
+	if query.To != "" {
+		to, err := time.Parse(reportDateLayout, query.To)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "to must be in YYYY-MM-DD format"})
+			return query, filter, false
+		}
+		to = to.AddDate(0, 0, 1)
+		filter.To = &to
+	}
+
+	filter.CinemaID = query.CinemaID
+	filter.GroupBy = query.GroupBy
+
+	return query, filter, true
+}
+
+func writeCSV(ctx *gin.Context, filename string, header []string, records [][]string) {
+	ctx.Header("Content-Type", "text/csv; charset=utf-8")
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	ctx.Status(http.StatusOK)
+
+	writer := csv.NewWriter(ctx.Writer)
+	if err := writer.Write(header); err != nil {
+		config.GetLogger().Error("Failed to write CSV header", "error", err)
+		return
+	}
+	if err := writer.WriteAll(records); err != nil {
+		config.GetLogger().Error("Failed to write CSV records", "error", err)
+	}
+}
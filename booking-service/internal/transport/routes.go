@@ -6,8 +6,14 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func RegisterRoutes(router *gin.Engine, bookingService services.BookingService) {
+func RegisterRoutes(router *gin.Engine, bookingService services.BookingService, reportService services.ReportService, productService services.ProductService, voucherService services.VoucherService) {
 	bookingHandler := NewBookingHandler(bookingService)
+	reportHandler := NewReportHandler(reportService)
+	productHandler := NewProductHandler(productService)
+	voucherHandler := NewVoucherHandler(voucherService)
 
 	bookingHandler.BookingRoutes(router)
+	reportHandler.ReportRoutes(router)
+	productHandler.ProductRoutes(router)
+	voucherHandler.VoucherRoutes(router)
 }
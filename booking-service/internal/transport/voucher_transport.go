@@ -0,0 +1,135 @@
+package transport
+
+import (
+	"booking-service/internal/auth"
+	"booking-service/internal/constants"
+	"booking-service/internal/dto"
+	"booking-service/internal/middleware"
+	"booking-service/internal/services"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type voucherTransport struct {
+	service services.VoucherService
+}
+
+func NewVoucherHandler(service services.VoucherService) *voucherTransport {
+	return &voucherTransport{
+		service: service,
+	}
+}
+
+func (h *voucherTransport) VoucherRoutes(ctx *gin.Engine) {
+	ctx.GET("/vouchers/:code/balance", h.Balance)
+	ctx.POST("/vouchers/purchase", h.Purchase)
+	ctx.GET("/vouchers/purchased", h.ListPurchased)
+
+	api := ctx.Group("/vouchers", middleware.AdminOnly())
+	{
+		api.POST("", h.Issue)
+		api.GET("/:code", h.GetByCode)
+		api.POST("/:code/deactivate", h.Deactivate)
+	}
+}
+
+func (h *voucherTransport) Issue(ctx *gin.Context) {
+	var req dto.VoucherCreateRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	voucher, err := h.service.Issue(req)
+	if err != nil {
+		if errors.Is(err, constants.ErrVoucherExpired) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "expires_at must be in the future"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, voucher)
+}
+
+// Purchase sells a gift card to the caller of the bearer JWT.
+func (h *voucherTransport) Purchase(ctx *gin.Context) {
+	userID, err := auth.BearerUserID(ctx.GetHeader("Authorization"))
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req dto.VoucherPurchaseRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	voucher, err := h.service.Purchase(userID, req)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, voucher)
+}
+
+func (h *voucherTransport) ListPurchased(ctx *gin.Context) {
+	userID, err := auth.BearerUserID(ctx.GetHeader("Authorization"))
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	vouchers, err := h.service.ListPurchased(userID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, vouchers)
+}
+
+func (h *voucherTransport) GetByCode(ctx *gin.Context) {
+	voucher, err := h.service.GetByCode(ctx.Param("code"))
+	if err != nil {
+		respondVoucherError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, voucher)
+}
+
+// Balance is the public view of a voucher: no purchaser and no ledger.
+func (h *voucherTransport) Balance(ctx *gin.Context) {
+	balance, err := h.service.Balance(ctx.Param("code"))
+	if err != nil {
+		respondVoucherError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, balance)
+}
+
+func (h *voucherTransport) Deactivate(ctx *gin.Context) {
+	voucher, err := h.service.Deactivate(ctx.Param("code"))
+	if err != nil {
+		respondVoucherError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, voucher)
+}
+
+func respondVoucherError(ctx *gin.Context, err error) {
+	if errors.Is(err, constants.ErrVoucherNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
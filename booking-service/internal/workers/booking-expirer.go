@@ -39,3 +39,20 @@ func StartEndedSessionsWorker(bookingService services.BookingService) {
 		}
 	}
 }
+
+func StartRemindersWorker(reminderService services.ReminderService) {
+	ticker := time.NewTicker(time.Minute)
+
+	logger := config.GetLogger()
+	logger.Info("Reminders worker started", "interval", "1 minute")
+
+	for range ticker.C {
+		if err := reminderService.SendHoldWarnings(); err != nil {
+			logger.Error("Failed to send hold expiry warnings", "error", err)
+		}
+
+		if err := reminderService.SendSessionReminders(); err != nil {
+			logger.Error("Failed to send session reminders", "error", err)
+		}
+	}
+}
@@ -2,10 +2,12 @@ package main
 
 import (
 	"cinema-service/internal/config"
+	"cinema-service/internal/infrastructure"
 	"cinema-service/internal/models"
 	"cinema-service/internal/repository"
 	"cinema-service/internal/services"
 	"cinema-service/internal/transport"
+	"cinema-service/internal/workers"
 	"log/slog"
 	"os"
 
@@ -18,15 +20,40 @@ func main() {
 	db := config.Connect()
 	logger := config.InitLogger()
 
+	// the legacy unique index on seats did not include hall_id
+	if db.Migrator().HasIndex(&models.Seat{}, "idx_hall_row_number") {
+		if err := db.Migrator().DropIndex(&models.Seat{}, "idx_hall_row_number"); err != nil {
+			log.Error("failed to drop legacy seat index", "error", err)
+			os.Exit(1)
+		}
+	}
+
+	// ticket category discounts used to be fixed in booking-service; seed them
+	// as rules when the column first appears
+	seedCategoryRules := !db.Migrator().HasColumn(&models.PriceRule{}, "TicketCategory")
+
 	if err := db.AutoMigrate(
+		&models.Cinema{},
 		&models.Hall{},
+		&models.SeatGroup{},
 		&models.Seat{},
 		&models.Session{},
+		&models.PriceRule{},
+		&models.Holiday{},
+		&models.ScheduleTemplate{},
+		&models.SeatBlock{},
 	); err != nil {
 		log.Error("failed to migrate database", "error", err)
 		os.Exit(1)
 	}
 
+	if seedCategoryRules {
+		if err := db.Create(models.DefaultTicketCategoryRules()).Error; err != nil {
+			log.Error("failed to seed ticket category rules", "error", err)
+			os.Exit(1)
+		}
+	}
+
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "8081"
@@ -34,15 +61,35 @@ func main() {
 
 	r := gin.Default()
 
+	cinemaRepo := repository.NewCinemaRepository(db, logger)
 	hallRepo := repository.NewHallRepository(db, logger)
 	seatRepo := repository.NewSeatRepository(db, logger)
 	sessionRepo := repository.NewSessionRepository(db, logger)
+	priceRuleRepo := repository.NewPriceRuleRepository(db, logger)
+	holidayRepo := repository.NewHolidayRepository(db, logger)
+	templateRepo := repository.NewScheduleTemplateRepository(db, logger)
+	seatBlockRepo := repository.NewSeatBlockRepository(db, logger)
+	seatGroupRepo := repository.NewSeatGroupRepository(db, logger)
+
+	sessionEvents := infrastructure.NewSessionEventPublisher(logger)
+
+	cinemaService := services.NewCinemaService(cinemaRepo, logger)
+	if err := cinemaService.EnsureDefaultCinema(); err != nil {
+		log.Error("failed to assign halls to default cinema", "error", err)
+		os.Exit(1)
+	}
+
+	hallService := services.NewHallService(hallRepo, cinemaRepo, sessionRepo, sessionEvents, logger)
+	seatService := services.NewSeatService(seatRepo, seatGroupRepo, hallRepo, sessionRepo, sessionEvents, logger)
+	pricingService := services.NewPricingService(priceRuleRepo, holidayRepo, sessionRepo, seatRepo, hallRepo, cinemaRepo, logger)
+	sessionService := services.NewSessionService(sessionRepo, hallRepo, cinemaRepo, seatRepo, seatBlockRepo, seatGroupRepo, pricingService, sessionEvents, logger)
+	seatBlockService := services.NewSeatBlockService(seatBlockRepo, sessionRepo, seatRepo, logger)
+
+	templateService := services.NewScheduleTemplateService(templateRepo, sessionRepo, hallRepo, cinemaRepo, sessionEvents, logger)
 
-	hallService := services.NewHallService(hallRepo, logger)
-	seatService := services.NewSeatService(seatRepo, hallRepo, logger)
-	sessionService := services.NewSessionService(sessionRepo, hallRepo, logger)
+	go workers.StartSessionStatusWorker(sessionService, logger)
 
-	transport.RegisterRoutes(r, logger, hallService, seatService, sessionService)
+	transport.RegisterRoutes(r, logger, cinemaService, hallService, seatService, sessionService, pricingService, templateService, seatBlockService)
 
 	if err := r.Run(":" + port); err != nil {
 		log.Error("failed to start server", slog.Any("error", err))
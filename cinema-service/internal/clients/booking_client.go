@@ -0,0 +1,102 @@
+package clients
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var httpClient = &http.Client{
+	Timeout: 5 * time.Second,
+}
+
+func getBookingServiceURL() string {
+	url := os.Getenv("BOOKING_SERVICE_URL")
+	if url == "" {
+		return "http://localhost:8082"
+	}
+	return url
+}
+
+type bookedSeatsResponse struct {
+	SeatIDs []uint `json:"seat_ids"`
+}
+
+func GetBookedSeatIDs(sessionID uint) ([]uint, error) {
+	url := fmt.Sprintf("%s/bookings/sessions/%d/booked-seats", getBookingServiceURL(), sessionID)
+
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("booking service returned status %d for session %d", resp.StatusCode, sessionID)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var booked bookedSeatsResponse
+
+	if err := json.Unmarshal(body, &booked); err != nil {
+		return nil, err
+	}
+
+	return booked.SeatIDs, nil
+}
+
+type activeBookingsResponse struct {
+	Count int `json:"count"`
+}
+
+// CountActiveBookings returns how many pending or confirmed bookings hold any
+// of the sessions or seats.
+func CountActiveBookings(sessionIDs, seatIDs []uint) (int, error) {
+	query := url.Values{}
+	if len(sessionIDs) > 0 {
+		query.Set("session_ids", joinIDs(sessionIDs))
+	}
+	if len(seatIDs) > 0 {
+		query.Set("seat_ids", joinIDs(seatIDs))
+	}
+	if len(query) == 0 {
+		return 0, nil
+	}
+
+	resp, err := httpClient.Get(getBookingServiceURL() + "/bookings/active?" + query.Encode())
+	if err != nil {
+		return 0, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("booking service returned status %d for active bookings", resp.StatusCode)
+	}
+
+	var active activeBookingsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&active); err != nil {
+		return 0, err
+	}
+
+	return active.Count, nil
+}
+
+func joinIDs(ids []uint) string {
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		parts = append(parts, strconv.FormatUint(uint64(id), 10))
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,82 @@
+package clients
+
+import (
+	"cinema-service/internal/constants"
+	"cinema-service/internal/dto"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+)
+
+func getMovieServiceURL() string {
+	url := os.Getenv("MOVIE_SERVICE_URL")
+	if url == "" {
+		return "http://localhost:8083"
+	}
+	return url
+}
+
+func GetMovie(movieID uint) (*dto.MovieResponse, error) {
+	url := fmt.Sprintf("%s/movies/%d", getMovieServiceURL(), movieID)
+
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, constants.ErrMovieNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("movie service returned status %d for movie %d", resp.StatusCode, movieID)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var movie dto.MovieResponse
+
+	if err := json.Unmarshal(body, &movie); err != nil {
+		return nil, err
+	}
+
+	return &movie, nil
+}
+
+// GetMovies fetches several movies in one request. Movies unknown to the
+// movie service are missing from the result.
+func GetMovies(movieIDs []uint) (map[uint]dto.MovieResponse, error) {
+	movies := make(map[uint]dto.MovieResponse, len(movieIDs))
+	if len(movieIDs) == 0 {
+		return movies, nil
+	}
+
+	url := fmt.Sprintf("%s/movies/?ids=%s", getMovieServiceURL(), joinIDs(movieIDs))
+
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("movie service returned status %d for movies %v", resp.StatusCode, movieIDs)
+	}
+
+	var list []dto.MovieResponse
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, err
+	}
+
+	for _, movie := range list {
+		movies[movie.ID] = movie
+	}
+	return movies, nil
+}
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"cinema-service/internal/constants"
+	"os"
+	"strconv"
+	"time"
+)
+
+// CleaningBuffer is the minimum gap kept between two sessions in one hall.
+func CleaningBuffer() time.Duration {
+	minutes := constants.DefaultCleaningBufferMinutes
+
+	if value := os.Getenv("SESSION_CLEANING_BUFFER_MINUTES"); value != "" {
+		if parsed, err := strconv.Atoi(value); err == nil && parsed >= 0 {
+			minutes = parsed
+		}
+	}
+
+	return time.Duration(minutes) * time.Minute
+}
+
+// AdsDuration is the ads and trailer block shown before every movie.
+func AdsDuration() time.Duration {
+	minutes := constants.DefaultAdsMinutes
+
+	if value := os.Getenv("SESSION_ADS_MINUTES"); value != "" {
+		if parsed, err := strconv.Atoi(value); err == nil && parsed >= 0 {
+			minutes = parsed
+		}
+	}
+
+	return time.Duration(minutes) * time.Minute
+}
@@ -0,0 +1,6 @@
+package constants
+
+const (
+	DefaultCinemaName     = "Main"
+	DefaultCinemaTimezone = "UTC"
+)
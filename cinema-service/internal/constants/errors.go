@@ -0,0 +1,35 @@
+package constants
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrCinemaNotFound = errors.New("cinema not found")
+var ErrCinemaHasHalls = errors.New("cinema still has halls")
+var ErrHallNumberTaken = errors.New("hall number already exists in this cinema")
+var ErrHallCapability = errors.New("hall does not support the session format")
+var ErrActiveBookings = errors.New("there are active bookings, use force to cancel and refund them")
+var ErrSeatNotInHall = errors.New("seat does not belong to the session hall")
+var ErrSeatAlreadyGrouped = errors.New("seat already belongs to a seat group")
+var ErrSeatGroupNameTaken = errors.New("seat group name already exists in this hall")
+var ErrSeatBooked = errors.New("seat is already booked for this session")
+var ErrSeatBlockNotFound = errors.New("seat is not blocked for this session")
+var ErrSessionClosed = errors.New("session is already finished or cancelled")
+var ErrMovieNotFound = errors.New("movie not found")
+var ErrMovieEnded = errors.New("movie is no longer showing")
+var ErrInvalidIDList = fmt.Errorf("ids must be a comma-separated list of at most %d ids", MaxBatchIDs)
+var ErrInvalidStatusTransition = errors.New("invalid session status transition")
+var ErrSessionOverlap = errors.New("session overlaps with other sessions in the hall")
+
+type SessionOverlapError struct {
+	ConflictingIDs []uint
+}
+
+func (e *SessionOverlapError) Error() string {
+	return fmt.Sprintf("%s: %v", ErrSessionOverlap, e.ConflictingIDs)
+}
+
+func (e *SessionOverlapError) Unwrap() error {
+	return ErrSessionOverlap
+}
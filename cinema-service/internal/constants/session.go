@@ -0,0 +1,49 @@
+package constants
+
+const (
+	DefaultCleaningBufferMinutes = 15
+	DefaultAdsMinutes            = 15
+	FreeSlotDateLayout           = "2006-01-02"
+)
+
+const (
+	DefaultSessionPageSize = 50
+	DefaultSessionSort     = "start_time"
+)
+
+// MaxBatchIDs caps the ids accepted by the ?ids= batch lookups.
+const MaxBatchIDs = 100
+
+const MovieStatusEnded = "ended"
+
+const (
+	SessionsTopic             = "sessions"
+	SessionStatusChangedEvent = "session.status_changed"
+	BookingsRevokedEvent      = "session.bookings_revoked"
+)
+
+const (
+	RevokeReasonHallDeleted    = "hall_deleted"
+	RevokeReasonSeatDeleted    = "seat_deleted"
+	RevokeReasonSessionDeleted = "session_deleted"
+)
+
+const MaxTemplateDays = 366
+
+const (
+	CalendarProdID   = "-//Cinema Hall//cinema-service//EN"
+	CalendarPastDays = 7
+	CalendarDays     = 90
+)
+
+const (
+	OccurrenceCreated  = "created"
+	OccurrencePlanned  = "planned"
+	OccurrenceExists   = "exists"
+	OccurrencePast     = "past"
+	OccurrenceConflict = "conflict"
+	OccurrenceShifted  = "shifted"
+	OccurrenceCanceled = "cancelled"
+	OccurrenceFailed   = "failed"
+	OccurrenceBooked   = "booked"
+)
@@ -0,0 +1,23 @@
+package dto
+
+import "cinema-service/internal/models"
+
+type CreateCinemaRequest struct {
+	Name         string              `json:"name" binding:"required"`
+	Address      string              `json:"address"`
+	Timezone     string              `json:"timezone"`
+	OpeningHours models.OpeningHours `json:"opening_hours"`
+
+	SalesOpenDays     int `json:"sales_open_days" binding:"omitempty,min=0,max=365"`
+	SalesCloseMinutes int `json:"sales_close_minutes" binding:"omitempty,min=0,max=1440"`
+}
+
+type UpdateCinemaRequest struct {
+	Name         *string             `json:"name"`
+	Address      *string             `json:"address"`
+	Timezone     *string             `json:"timezone"`
+	OpeningHours models.OpeningHours `json:"opening_hours"`
+
+	SalesOpenDays     *int `json:"sales_open_days" binding:"omitempty,min=0,max=365"`
+	SalesCloseMinutes *int `json:"sales_close_minutes" binding:"omitempty,min=0,max=1440"`
+}
@@ -1,9 +1,22 @@
 package dto
 
 type CreateHallRequest struct {
-	Number int `json:"number" binding:"required"`
+	CinemaID           uint `json:"cinema_id" binding:"required"`
+	Number             int  `json:"number" binding:"required"`
+	Supports3D         bool `json:"supports_3d"`
+	SupportsIMAX       bool `json:"supports_imax"`
+	SupportsDolbyAtmos bool `json:"supports_dolby_atmos"`
 }
 
 type UpdateHallRequest struct {
-	Number *int `json:"number"`
+	CinemaID           *uint `json:"cinema_id"`
+	Number             *int  `json:"number"`
+	Supports3D         *bool `json:"supports_3d"`
+	SupportsIMAX       *bool `json:"supports_imax"`
+	SupportsDolbyAtmos *bool `json:"supports_dolby_atmos"`
+}
+
+type HallListQuery struct {
+	CinemaID uint   `form:"cinema_id"`
+	IDs      string `form:"ids"`
 }
@@ -0,0 +1,8 @@
+package dto
+
+type MovieResponse struct {
+	ID          uint   `json:"id"`
+	Title       string `json:"title"`
+	Duration    uint   `json:"duration"`
+	MovieStatus string `json:"movie_status"`
+}
@@ -0,0 +1,58 @@
+package dto
+
+import "cinema-service/internal/models"
+
+type PriceRuleRequest struct {
+	Name           string                 `json:"name" binding:"required"`
+	Priority       int                    `json:"priority"`
+	Active         *bool                  `json:"active"`
+	SeatType       *models.SeatType       `json:"seat_type,omitempty" binding:"omitempty,oneof=standard vip wheelchair"`
+	TicketCategory *models.TicketCategory `json:"ticket_category,omitempty" binding:"omitempty,oneof=adult child student senior"`
+	HallID         *uint                  `json:"hall_id,omitempty"`
+	MovieID        *uint                  `json:"movie_id,omitempty"`
+	Format         *models.SessionFormat  `json:"format,omitempty" binding:"omitempty,oneof=2d 3d imax imax_3d"`
+	Weekdays       []string               `json:"weekdays,omitempty" binding:"omitempty,dive,oneof=mon tue wed thu fri sat sun"`
+	TimeFrom       string                 `json:"time_from,omitempty"`
+	TimeTo         string                 `json:"time_to,omitempty"`
+	HolidaysOnly   bool                   `json:"holidays_only"`
+	MinOccupancy   *int                   `json:"min_occupancy,omitempty" binding:"omitempty,min=0,max=100"`
+	Action         models.PriceRuleAction `json:"action" binding:"required,oneof=multiply add fixed"`
+	Value          float64                `json:"value"`
+}
+
+type CreateHolidayRequest struct {
+	Date string `json:"date" binding:"required"`
+	Name string `json:"name"`
+}
+
+type PricePreviewQuery struct {
+	SessionID uint                  `form:"session_id" binding:"required"`
+	SeatID    uint                  `form:"seat_id" binding:"required"`
+	Category  models.TicketCategory `form:"category" binding:"omitempty,oneof=adult child student senior"`
+}
+
+type AppliedPriceRule struct {
+	RuleID      uint                   `json:"rule_id"`
+	Name        string                 `json:"name"`
+	Action      models.PriceRuleAction `json:"action"`
+	Value       float64                `json:"value"`
+	PriceBefore int                    `json:"price_before"`
+	PriceAfter  int                    `json:"price_after"`
+}
+
+type PriceBreakdown struct {
+	BasePrice       int                `json:"base_price"`
+	FormatSurcharge int                `json:"format_surcharge"`
+	Price           int                `json:"price"`
+	AppliedRules    []AppliedPriceRule `json:"applied_rules"`
+}
+
+type PricePreviewResponse struct {
+	SessionID uint                  `json:"session_id"`
+	SeatID    uint                  `json:"seat_id"`
+	SeatType  models.SeatType       `json:"seat_type"`
+	Category  models.TicketCategory `json:"category"`
+	Occupancy int                   `json:"occupancy_percent"`
+	Holiday   bool                  `json:"holiday"`
+	PriceBreakdown
+}
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"cinema-service/internal/models"
+	"time"
+)
+
+type ScheduleTemplateRequest struct {
+	Name       string   `json:"name"`
+	MovieID    uint     `json:"movie_id" binding:"required"`
+	HallID     uint     `json:"hall_id" binding:"required"`
+	Weekdays   []string `json:"weekdays" binding:"required,min=1,dive,oneof=mon tue wed thu fri sat sun"`
+	StartTimes []string `json:"start_times" binding:"required,min=1"`
+	DateFrom   string   `json:"date_from" binding:"required"`
+	DateTo     string   `json:"date_to" binding:"required"`
+
+	Format           models.SessionFormat `json:"format" binding:"omitempty,oneof=2d 3d imax imax_3d"`
+	DolbyAtmos       bool                 `json:"dolby_atmos"`
+	AudioLanguage    string               `json:"audio_language" binding:"omitempty,min=2,max=3,alpha,lowercase"`
+	SubtitleLanguage string               `json:"subtitle_language" binding:"omitempty,min=2,max=3,alpha,lowercase"`
+}
+
+type TemplateGenerateQuery struct {
+	DryRun bool `form:"dry_run"`
+}
+
+type TemplateShiftRequest struct {
+	Minutes int `json:"minutes" binding:"required"`
+}
+
+type TemplateOccurrence struct {
+	StartTime      time.Time `json:"start_time"`
+	SessionID      uint      `json:"session_id,omitempty"`
+	Result         string    `json:"result"`
+	ConflictingIDs []uint    `json:"conflicting_session_ids,omitempty"`
+	Error          string    `json:"error,omitempty"`
+}
+
+type TemplateRunResult struct {
+	TemplateID  uint                 `json:"template_id"`
+	DryRun      bool                 `json:"dry_run,omitempty"`
+	Applied     int                  `json:"applied"`
+	Skipped     int                  `json:"skipped"`
+	Occurrences []TemplateOccurrence `json:"occurrences"`
+}
@@ -3,13 +3,102 @@ package dto
 import "cinema-service/internal/models"
 
 type CreateSeatRequest struct {
-	Row    int             `json:"row" binding:"required,min=1"`
-	Number int             `json:"number" binding:"required,min=1"`
-	Type   models.SeatType `json:"type" binding:"omitempty,oneof=standard vip wheelchair"`
+	Row     int             `json:"row" binding:"required,min=1"`
+	Number  int             `json:"number" binding:"required,min=1"`
+	Type    models.SeatType `json:"type" binding:"omitempty,oneof=standard vip wheelchair"`
+	X       *float64        `json:"x,omitempty"`
+	Y       *float64        `json:"y,omitempty"`
+	Section string          `json:"section,omitempty" binding:"omitempty,max=50"`
 }
 
 type UpdateSeatRequest struct {
-	Row    *int             `json:"row,omitempty" binding:"omitempty,min=1"`
-	Number *int             `json:"number,omitempty" binding:"omitempty,min=1"`
-	Type   *models.SeatType `json:"type,omitempty" binding:"omitempty,oneof=standard vip wheelchair"`
+	Row     *int             `json:"row,omitempty" binding:"omitempty,min=1"`
+	Number  *int             `json:"number,omitempty" binding:"omitempty,min=1"`
+	Type    *models.SeatType `json:"type,omitempty" binding:"omitempty,oneof=standard vip wheelchair"`
+	X       *float64         `json:"x,omitempty"`
+	Y       *float64         `json:"y,omitempty"`
+	Section *string          `json:"section,omitempty" binding:"omitempty,max=50"`
+
+	// ClearPosition removes the seat's coordinates; x and y are ignored.
+	ClearPosition bool `json:"clear_position,omitempty"`
+}
+
+type CreateSeatGroupRequest struct {
+	Name         string `json:"name" binding:"required,max=50"`
+	SeatIDs      []uint `json:"seat_ids" binding:"required,min=2"`
+	SellTogether *bool  `json:"sell_together,omitempty"`
+}
+
+type RowLayoutSpec struct {
+	Row         int             `json:"row" binding:"required,min=1"`
+	SeatsPerRow *int            `json:"seats_per_row,omitempty" binding:"omitempty,min=1"`
+	Gaps        []int           `json:"gaps,omitempty" binding:"omitempty,dive,min=1"`
+	Type        models.SeatType `json:"type,omitempty" binding:"omitempty,oneof=standard vip wheelchair"`
+	Section     string          `json:"section,omitempty" binding:"omitempty,max=50"`
+}
+
+type HallLayoutRequest struct {
+	Rows        int             `json:"rows" binding:"required,min=1,max=100"`
+	SeatsPerRow int             `json:"seats_per_row" binding:"required,min=1,max=100"`
+	Gaps        []int           `json:"gaps,omitempty" binding:"omitempty,dive,min=1"`
+	DefaultType models.SeatType `json:"default_type,omitempty" binding:"omitempty,oneof=standard vip wheelchair"`
+	RowSpecs    []RowLayoutSpec `json:"row_specs,omitempty" binding:"omitempty,dive"`
+	Replace     bool            `json:"replace"`
+}
+
+type SeatRowResponse struct {
+	Row   int           `json:"row"`
+	Seats []models.Seat `json:"seats"`
+}
+
+type SeatImportRow struct {
+	Line    int             `json:"line"`
+	Row     int             `json:"row"`
+	Number  int             `json:"number"`
+	Type    models.SeatType `json:"type"`
+	X       *float64        `json:"x,omitempty"`
+	Y       *float64        `json:"y,omitempty"`
+	Section string          `json:"section,omitempty"`
+	Error   string          `json:"error,omitempty"`
+}
+
+type LayoutImportQuery struct {
+	Format string `form:"format" binding:"omitempty,oneof=json csv"`
+	Mode   string `form:"mode" binding:"omitempty,oneof=append replace"`
+	DryRun bool   `form:"dry_run"`
+}
+
+type LayoutImportResult struct {
+	DryRun  bool            `json:"dry_run"`
+	Mode    string          `json:"mode"`
+	Total   int             `json:"total"`
+	Valid   int             `json:"valid"`
+	Created int             `json:"created"`
+	Errors  []SeatImportRow `json:"errors"`
+}
+
+type SessionSeatResponse struct {
+	ID          uint                   `json:"id"`
+	Row         int                    `json:"row"`
+	Number      int                    `json:"number"`
+	Type        models.SeatType        `json:"type"`
+	Price       int                    `json:"price"`
+	Blocked     bool                   `json:"blocked"`
+	BlockReason models.SeatBlockReason `json:"block_reason,omitempty"`
+
+	// CategoryPrices holds the seat price for every ticket category; Price is
+	// the adult one.
+	CategoryPrices map[models.TicketCategory]int `json:"category_prices"`
+
+	X            *float64 `json:"x,omitempty"`
+	Y            *float64 `json:"y,omitempty"`
+	Section      string   `json:"section,omitempty"`
+	GroupID      *uint    `json:"group_id,omitempty"`
+	SellTogether bool     `json:"sell_together,omitempty"`
+}
+
+type SeatBlockRequest struct {
+	SeatIDs []uint                 `json:"seat_ids" binding:"required,min=1"`
+	Reason  models.SeatBlockReason `json:"reason" binding:"required,oneof=broken house press distancing other"`
+	Note    string                 `json:"note"`
 }
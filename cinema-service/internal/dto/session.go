@@ -1,16 +1,148 @@
 package dto
 
-import "time"
+import (
+	"cinema-service/internal/models"
+	"time"
+)
 
 type CreateSessionRequest struct {
 	MovieID   uint      `json:"movie_id" binding:"required"`
 	HallID    uint      `json:"hall_id" binding:"required"`
 	StartTime time.Time `json:"start_time" binding:"required"`
-	EndTime   time.Time `json:"end_time" binding:"required,gtfield=StartTime"`
+	EndTime   time.Time `json:"end_time" binding:"omitempty,gtfield=StartTime"`
+
+	Format           models.SessionFormat `json:"format" binding:"omitempty,oneof=2d 3d imax imax_3d"`
+	DolbyAtmos       bool                 `json:"dolby_atmos"`
+	AudioLanguage    string               `json:"audio_language" binding:"omitempty,min=2,max=3,alpha,lowercase"`
+	SubtitleLanguage string               `json:"subtitle_language" binding:"omitempty,min=2,max=3,alpha,lowercase"`
+
+	SalesOpenAt   *time.Time `json:"sales_open_at"`
+	SalesCloseAt  *time.Time `json:"sales_close_at"`
+	PresaleOpenAt *time.Time `json:"presale_open_at"`
+}
+
+type FreeSlotsQuery struct {
+	Date        string `form:"date" binding:"required"`
+	MinDuration int    `form:"min_duration" binding:"omitempty,min=1"`
+}
+
+type FreeSlot struct {
+	Start   time.Time `json:"start"`
+	End     time.Time `json:"end"`
+	Minutes int       `json:"minutes"`
 }
 
 type UpdateSessionRequest struct {
 	StartTime *time.Time `json:"start_time,omitempty"`
 	EndTime   *time.Time `json:"end_time,omitempty"`
 	Status    *string    `json:"status,omitempty" binding:"omitempty,oneof=scheduled ongoing finished cancelled"`
+
+	Format           *models.SessionFormat `json:"format,omitempty" binding:"omitempty,oneof=2d 3d imax imax_3d"`
+	DolbyAtmos       *bool                 `json:"dolby_atmos,omitempty"`
+	AudioLanguage    *string               `json:"audio_language,omitempty" binding:"omitempty,min=2,max=3,alpha,lowercase"`
+	SubtitleLanguage *string               `json:"subtitle_language,omitempty" binding:"omitempty,min=2,max=3,alpha,lowercase"`
+
+	SalesOpenAt   *time.Time `json:"sales_open_at,omitempty"`
+	SalesCloseAt  *time.Time `json:"sales_close_at,omitempty"`
+	PresaleOpenAt *time.Time `json:"presale_open_at,omitempty"`
+
+	// ClearSalesWindow removes the sales window before the times above are
+	// applied, so sales are open until the session starts.
+	ClearSalesWindow bool `json:"clear_sales_window,omitempty"`
+}
+
+type SessionStatusEvent struct {
+	Event     string    `json:"event"`
+	SessionID uint      `json:"session_id"`
+	MovieID   uint      `json:"movie_id"`
+	HallID    uint      `json:"hall_id"`
+	OldStatus string    `json:"old_status"`
+	NewStatus string    `json:"new_status"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// BookingsRevokedEvent asks booking-service to cancel and refund the active
+// bookings of force-deleted sessions or seats.
+type BookingsRevokedEvent struct {
+	Event      string    `json:"event"`
+	Reason     string    `json:"reason"`
+	SessionIDs []uint    `json:"session_ids"`
+	SeatIDs    []uint    `json:"seat_ids"`
+	RevokedAt  time.Time `json:"revoked_at"`
+}
+
+type DeleteQuery struct {
+	Force bool `form:"force"`
+}
+
+type SessionSearchQuery struct {
+	IDs      string `form:"ids"`
+	CinemaID uint   `form:"cinema_id"`
+	Date     string `form:"date"`
+	DateFrom string `form:"date_from"`
+	DateTo   string `form:"date_to"`
+	MovieID  uint   `form:"movie_id"`
+	HallID   uint   `form:"hall_id"`
+	Status   string `form:"status" binding:"omitempty,oneof=scheduled ongoing finished cancelled"`
+	TimeFrom string `form:"time_from"`
+	TimeTo   string `form:"time_to"`
+
+	Format           string `form:"format" binding:"omitempty,oneof=2d 3d imax imax_3d"`
+	DolbyAtmos       *bool  `form:"dolby_atmos"`
+	AudioLanguage    string `form:"audio_language"`
+	SubtitleLanguage string `form:"subtitle_language"`
+
+	Sort     string `form:"sort" binding:"omitempty,oneof=start_time -start_time movie_id -movie_id hall_id -hall_id"`
+	Page     int    `form:"page" binding:"omitempty,min=1"`
+	PageSize int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+type ScheduleQuery struct {
+	CinemaID uint   `form:"cinema_id"`
+	Date     string `form:"date"`
+	MovieID  uint   `form:"movie_id"`
+	HallID   uint   `form:"hall_id"`
+}
+
+type ScheduleSession struct {
+	SessionID  uint      `json:"session_id"`
+	HallID     uint      `json:"hall_id"`
+	HallNumber int       `json:"hall_number"`
+	Format     string    `json:"format"`
+	DolbyAtmos bool      `json:"dolby_atmos"`
+	Audio      string    `json:"audio_language,omitempty"`
+	Subtitles  string    `json:"subtitle_language,omitempty"`
+	StartTime  time.Time `json:"start_time"`
+	EndTime    time.Time `json:"end_time"`
+	Status     string    `json:"status"`
+}
+
+type ScheduleMovie struct {
+	MovieID  uint              `json:"movie_id"`
+	Title    string            `json:"title"`
+	Duration uint              `json:"duration"`
+	Sessions []ScheduleSession `json:"sessions"`
+}
+
+type ScheduleResponse struct {
+	CinemaID uint            `json:"cinema_id,omitempty"`
+	Date     string          `json:"date"`
+	Movies   []ScheduleMovie `json:"movies"`
+}
+
+type CalendarQuery struct {
+	MovieID  uint
+	HallID   uint
+	CinemaID uint
+}
+
+// BookingCalendarQuery describes a booking of the session for its .ics
+// attachment. booking-service renders its attachments through this endpoint,
+// so the calendar format lives in one place.
+type BookingCalendarQuery struct {
+	BookingID uint   `form:"booking_id" binding:"required"`
+	SeatIDs   string `form:"seat_ids"`
+	Cancelled bool   `form:"cancelled"`
 }
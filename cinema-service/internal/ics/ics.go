@@ -0,0 +1,94 @@
+// Package ics renders iCalendar (RFC 5545) documents. cinema-service owns
+// every calendar: booking-service fetches its booking attachments from here.
+package ics
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	timestampLayout = "20060102T150405Z"
+	maxLineOctets   = 75
+)
+
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	Start       time.Time
+	End         time.Time
+	Cancelled   bool
+}
+
+// Write renders the events as a VCALENDAR. Times are written in UTC so the
+// document does not need VTIMEZONE definitions.
+func Write(w io.Writer, prodID, name string, events []Event) error {
+	out := bufio.NewWriter(w)
+	stamp := time.Now().UTC().Format(timestampLayout)
+
+	writeLine(out, "BEGIN:VCALENDAR")
+	writeLine(out, "VERSION:2.0")
+	writeLine(out, "PRODID:"+prodID)
+	writeLine(out, "CALSCALE:GREGORIAN")
+	writeLine(out, "METHOD:PUBLISH")
+	if name != "" {
+		writeLine(out, "X-WR-CALNAME:"+escape(name))
+	}
+
+	for _, event := range events {
+		writeLine(out, "BEGIN:VEVENT")
+		writeLine(out, "UID:"+event.UID)
+		writeLine(out, "DTSTAMP:"+stamp)
+		writeLine(out, "DTSTART:"+event.Start.UTC().Format(timestampLayout))
+		writeLine(out, "DTEND:"+event.End.UTC().Format(timestampLayout))
+		writeLine(out, "SUMMARY:"+escape(event.Summary))
+		if event.Location != "" {
+			writeLine(out, "LOCATION:"+escape(event.Location))
+		}
+		if event.Description != "" {
+			writeLine(out, "DESCRIPTION:"+escape(event.Description))
+		}
+		if event.Cancelled {
+			writeLine(out, "STATUS:CANCELLED")
+		} else {
+			writeLine(out, "STATUS:CONFIRMED")
+		}
+		writeLine(out, "END:VEVENT")
+	}
+
+	writeLine(out, "END:VCALENDAR")
+	return out.Flush()
+}
+
+func escape(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(value)
+}
+
+// writeLine folds content lines longer than 75 octets without splitting a
+// UTF-8 sequence, as required by RFC 5545.
+func writeLine(out *bufio.Writer, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		out.WriteString(line[:cut])
+		out.WriteString("\r\n ")
+		line = line[cut:]
+		limit = maxLineOctets - 1
+	}
+	out.WriteString(line)
+	out.WriteString("\r\n")
+}
@@ -0,0 +1,66 @@
+package ics
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestEscape(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"plain", "Hall 1", "Hall 1"},
+		{"comma and semicolon", "Row 5, seat 7; VIP", `Row 5\, seat 7\; VIP`},
+		{"backslash", `C:\films`, `C:\\films`},
+		{"newline", "line one\nline two", `line one\nline two`},
+		{"crlf", "line one\r\nline two", `line one\nline two`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := escape(tt.value); got != tt.want {
+				t.Errorf("escape(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteLine(t *testing.T) {
+	a := func(n int) string { return strings.Repeat("a", n) }
+
+	tests := []struct {
+		name string
+		line string
+		want string
+	}{
+		{"short", "SUMMARY:Film", "SUMMARY:Film\r\n"},
+		{"exactly 75 octets", a(75), a(75) + "\r\n"},
+		{"76 octets", a(76), a(75) + "\r\n a\r\n"},
+		{"continuation lines hold 74 octets", a(150), a(75) + "\r\n " + a(74) + "\r\n a\r\n"},
+		{"multibyte rune is not split", a(74) + "é", a(74) + "\r\n é\r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			out := bufio.NewWriter(&buf)
+			writeLine(out, tt.line)
+			if err := out.Flush(); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := buf.String(); got != tt.want {
+				t.Errorf("writeLine(%q) = %q, want %q", tt.line, got, tt.want)
+			}
+			for _, physical := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+				if len(physical) > maxLineOctets {
+					t.Errorf("line of %d octets exceeds %d", len(physical), maxLineOctets)
+				}
+			}
+		})
+	}
+}
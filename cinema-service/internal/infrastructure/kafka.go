@@ -0,0 +1,203 @@
+package infrastructure
+
+import (
+	"cinema-service/internal/constants"
+	"cinema-service/internal/dto"
+	"cinema-service/internal/models"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type SessionEventPublisher interface {
+	PublishStatusChanged(session models.Session, oldStatus models.SessionStatus) error
+	PublishBookingsRevoked(reason string, sessionIDs, seatIDs []uint) error
+}
+
+type kafkaSessionPublisher struct {
+	broker string
+	logger *slog.Logger
+
+	mu     sync.Mutex
+	writer *kafka.Writer
+}
+
+func getKafkaBroker(logger *slog.Logger) string {
+	broker := os.Getenv("KAFKA_BROKER")
+	if broker == "" {
+		logger.Warn("KAFKA_BROKER not set, using default localhost:9092")
+		return "localhost:9092"
+	}
+	return broker
+}
+
+func NewSessionEventPublisher(logger *slog.Logger) SessionEventPublisher {
+	publisher := &kafkaSessionPublisher{
+		broker: getKafkaBroker(logger),
+		logger: logger,
+	}
+
+	if _, err := publisher.getWriter(); err != nil {
+		logger.Warn("kafka is not available yet, the writer will be created on the next event", "error", err)
+	}
+
+	return publisher
+}
+
+// getWriter creates the writer on first use, so events are published as soon
+// as Kafka becomes reachable even if it was down when the service started.
+func (p *kafkaSessionPublisher) getWriter() (*kafka.Writer, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.writer != nil {
+		return p.writer, nil
+	}
+
+	if err := createTopic(p.broker, constants.SessionsTopic, p.logger); err != nil {
+		return nil, fmt.Errorf("failed to create kafka topic: %w", err)
+	}
+
+	p.writer = &kafka.Writer{
+		Addr:         kafka.TCP(p.broker),
+		Topic:        constants.SessionsTopic,
+		Balancer:     &kafka.LeastBytes{},
+		WriteTimeout: 10 * time.Second,
+		RequiredAcks: 1,
+	}
+	p.logger.Info("kafka writer initialized", "topic", constants.SessionsTopic, "broker", p.broker)
+
+	return p.writer, nil
+}
+
+func createTopic(broker, topic string, logger *slog.Logger) error {
+	conn, err := kafka.Dial("tcp", broker)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	partitions, err := conn.ReadPartitions()
+	if err == nil {
+		for _, p := range partitions {
+			if p.Topic == topic {
+				return nil
+			}
+		}
+	}
+
+	controller, err := conn.Controller()
+	if err != nil {
+		return err
+	}
+
+	controllerConn, err := kafka.Dial("tcp", net.JoinHostPort(controller.Host, strconv.Itoa(controller.Port)))
+	if err != nil {
+		return err
+	}
+	defer controllerConn.Close()
+
+	err = controllerConn.CreateTopics(kafka.TopicConfig{
+		Topic:             topic,
+		NumPartitions:     1,
+		ReplicationFactor: 1,
+	})
+	if err != nil && !strings.Contains(err.Error(), "TopicExistsException") &&
+		!strings.Contains(err.Error(), "topic already exists") {
+		return err
+	}
+
+	logger.Info("kafka topic ready", "topic", topic)
+	return nil
+}
+
+func (p *kafkaSessionPublisher) PublishStatusChanged(session models.Session, oldStatus models.SessionStatus) error {
+	writer, err := p.getWriter()
+	if err != nil {
+		return err
+	}
+
+	event := dto.SessionStatusEvent{
+		Event:     constants.SessionStatusChangedEvent,
+		SessionID: session.ID,
+		MovieID:   session.MovieID,
+		HallID:    session.HallID,
+		OldStatus: string(oldStatus),
+		NewStatus: string(session.Status),
+		StartTime: session.StartTime,
+		EndTime:   session.EndTime,
+		ChangedAt: time.Now(),
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(fmt.Sprintf("session-%d", session.ID)),
+		Value: payload,
+	}); err != nil {
+		p.logger.Error("failed to publish session event", "session_id", session.ID, "error", err)
+		return err
+	}
+
+	p.logger.Info(
+		"session status event published",
+		"session_id", session.ID,
+		"old_status", oldStatus,
+		"new_status", session.Status,
+	)
+	return nil
+}
+
+func (p *kafkaSessionPublisher) PublishBookingsRevoked(reason string, sessionIDs, seatIDs []uint) error {
+	writer, err := p.getWriter()
+	if err != nil {
+		return err
+	}
+
+	event := dto.BookingsRevokedEvent{
+		Event:      constants.BookingsRevokedEvent,
+		Reason:     reason,
+		SessionIDs: sessionIDs,
+		SeatIDs:    seatIDs,
+		RevokedAt:  time.Now(),
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(reason),
+		Value: payload,
+	}); err != nil {
+		p.logger.Error("failed to publish bookings revoked event", "reason", reason, "error", err)
+		return err
+	}
+
+	p.logger.Info(
+		"bookings revoked event published",
+		"reason", reason,
+		"session_ids", sessionIDs,
+		"seat_ids", seatIDs,
+	)
+	return nil
+}
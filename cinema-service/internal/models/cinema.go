@@ -0,0 +1,27 @@
+package models
+
+type OpeningPeriod struct {
+	Open  string `json:"open"`
+	Close string `json:"close"`
+}
+
+// OpeningHours maps a weekday (mon..sun) to the venue's opening period on that
+// day. A close time earlier than the open time means the venue closes after
+// midnight.
+type OpeningHours map[string]OpeningPeriod
+
+type Cinema struct {
+	Base
+	Name         string       `json:"name" gorm:"not null;uniqueIndex"`
+	Address      string       `json:"address"`
+	Timezone     string       `json:"timezone" gorm:"type:varchar(64);not null;default:'UTC'"`
+	OpeningHours OpeningHours `json:"opening_hours,omitempty" gorm:"type:jsonb;serializer:json"`
+
+	// Sales window applied to new sessions of the venue: ticket sales open
+	// SalesOpenDays before the start (0 opens them right away) and close
+	// SalesCloseMinutes before it.
+	SalesOpenDays     int `json:"sales_open_days" gorm:"not null;default:0"`
+	SalesCloseMinutes int `json:"sales_close_minutes" gorm:"not null;default:0"`
+
+	Halls []Hall `json:"halls,omitempty"`
+}
@@ -2,6 +2,12 @@ package models
 
 type Hall struct {
 	Base
-	Number int    `json:"number" gorm:"not null;uniques"`
-	Seats  []Seat `json:"seats"`
+	CinemaID *uint `json:"cinema_id" gorm:"uniqueIndex:idx_halls_cinema_number"`
+	Number   int   `json:"number" gorm:"not null;uniqueIndex:idx_halls_cinema_number"`
+
+	Supports3D         bool `json:"supports_3d" gorm:"not null;default:false"`
+	SupportsIMAX       bool `json:"supports_imax" gorm:"not null;default:false"`
+	SupportsDolbyAtmos bool `json:"supports_dolby_atmos" gorm:"not null;default:false"`
+
+	Seats []Seat `json:"seats"`
 }
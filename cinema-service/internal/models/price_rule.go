@@ -0,0 +1,70 @@
+package models
+
+type PriceRuleAction string
+
+const (
+	PriceRuleMultiply PriceRuleAction = "multiply"
+	PriceRuleAdd      PriceRuleAction = "add"
+	PriceRuleFixed    PriceRuleAction = "fixed"
+)
+
+type TicketCategory string
+
+const (
+	TicketAdult   TicketCategory = "adult"
+	TicketChild   TicketCategory = "child"
+	TicketStudent TicketCategory = "student"
+	TicketSenior  TicketCategory = "senior"
+)
+
+var TicketCategories = []TicketCategory{TicketAdult, TicketChild, TicketStudent, TicketSenior}
+
+// TicketCategoryRulePriority puts the seeded category discounts after the
+// other rules, so they apply to the final seat price.
+const TicketCategoryRulePriority = 1000
+
+// DefaultTicketCategoryRules are created once, when ticket categories are
+// added to an existing database.
+func DefaultTicketCategoryRules() []PriceRule {
+	rule := func(name string, category TicketCategory, value float64) PriceRule {
+		return PriceRule{
+			Name:           name,
+			Priority:       TicketCategoryRulePriority,
+			Active:         true,
+			TicketCategory: &category,
+			Action:         PriceRuleMultiply,
+			Value:          value,
+		}
+	}
+
+	return []PriceRule{
+		rule("Child ticket", TicketChild, 0.6),
+		rule("Student ticket", TicketStudent, 0.8),
+		rule("Senior ticket", TicketSenior, 0.7),
+	}
+}
+
+type PriceRule struct {
+	Base
+	Name           string          `json:"name" gorm:"not null"`
+	Priority       int             `json:"priority" gorm:"not null;default:0"`
+	Active         bool            `json:"active" gorm:"not null;default:true"`
+	SeatType       *SeatType       `json:"seat_type,omitempty" gorm:"type:varchar(20)"`
+	TicketCategory *TicketCategory `json:"ticket_category,omitempty" gorm:"type:varchar(20)"`
+	HallID         *uint           `json:"hall_id,omitempty"`
+	MovieID        *uint           `json:"movie_id,omitempty"`
+	Format         *SessionFormat  `json:"format,omitempty" gorm:"type:varchar(10)"`
+	Weekdays       string          `json:"weekdays,omitempty" gorm:"type:varchar(50)"`
+	TimeFrom       string          `json:"time_from,omitempty" gorm:"type:varchar(5)"`
+	TimeTo         string          `json:"time_to,omitempty" gorm:"type:varchar(5)"`
+	HolidaysOnly   bool            `json:"holidays_only" gorm:"not null;default:false"`
+	MinOccupancy   *int            `json:"min_occupancy,omitempty"`
+	Action         PriceRuleAction `json:"action" gorm:"type:varchar(20);not null"`
+	Value          float64         `json:"value" gorm:"not null"`
+}
+
+type Holiday struct {
+	Base
+	Date string `json:"date" gorm:"type:varchar(10);not null;uniqueIndex"`
+	Name string `json:"name"`
+}
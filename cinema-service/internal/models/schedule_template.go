@@ -0,0 +1,17 @@
+package models
+
+type ScheduleTemplate struct {
+	Base
+	Name       string `json:"name"`
+	MovieID    uint   `json:"movie_id" gorm:"not null"`
+	HallID     uint   `json:"hall_id" gorm:"not null"`
+	Weekdays   string `json:"weekdays" gorm:"type:varchar(50);not null"`
+	StartTimes string `json:"start_times" gorm:"type:varchar(255);not null"`
+	DateFrom   string `json:"date_from" gorm:"type:varchar(10);not null"`
+	DateTo     string `json:"date_to" gorm:"type:varchar(10);not null"`
+
+	Format           SessionFormat `json:"format" gorm:"type:varchar(10);not null;default:'2d'"`
+	DolbyAtmos       bool          `json:"dolby_atmos" gorm:"not null;default:false"`
+	AudioLanguage    string        `json:"audio_language,omitempty" gorm:"type:varchar(3)"`
+	SubtitleLanguage string        `json:"subtitle_language,omitempty" gorm:"type:varchar(3)"`
+}
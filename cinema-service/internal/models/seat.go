@@ -16,9 +16,26 @@ var SeatTypePrices = map[SeatType]int{
 
 type Seat struct {
 	Base
-	HallID uint     `json:"hall_id" gorm:"not null"`
 	Hall   Hall     `json:"-"`
-	Number int      `json:"number" gorm:"not null;uniqueIndex:idx_hall_row_number"`
-	Row    int      `json:"row" gorm:"not null;uniqueIndex:idx_hall_row_number"`
+	HallID uint     `json:"hall_id" gorm:"not null;uniqueIndex:idx_seats_hall_row_number"`
+	Number int      `json:"number" gorm:"not null;uniqueIndex:idx_seats_hall_row_number"`
+	Row    int      `json:"row" gorm:"not null;uniqueIndex:idx_seats_hall_row_number"`
 	Type   SeatType `json:"type" gorm:"default:'standard'"`
+
+	// X and Y place the seat on the hall plan, so curved rows and balconies
+	// can be drawn. Seats without coordinates are laid out by row and number.
+	X       *float64 `json:"x,omitempty"`
+	Y       *float64 `json:"y,omitempty"`
+	Section string   `json:"section,omitempty" gorm:"size:50"`
+	GroupID *uint    `json:"group_id,omitempty" gorm:"index"`
+}
+
+// SeatGroup joins neighbouring seats of a hall, e.g. a love seat. Groups that
+// are sold together can only be booked as a whole.
+type SeatGroup struct {
+	Base
+	HallID       uint   `json:"hall_id" gorm:"not null;uniqueIndex:idx_seat_groups_hall_name"`
+	Name         string `json:"name" gorm:"not null;uniqueIndex:idx_seat_groups_hall_name"`
+	SellTogether bool   `json:"sell_together" gorm:"not null;default:true"`
+	Seats        []Seat `json:"seats" gorm:"foreignKey:GroupID"`
 }
@@ -0,0 +1,21 @@
+package models
+
+type SeatBlockReason string
+
+const (
+	SeatBlockBroken     SeatBlockReason = "broken"
+	SeatBlockHouse      SeatBlockReason = "house"
+	SeatBlockPress      SeatBlockReason = "press"
+	SeatBlockDistancing SeatBlockReason = "distancing"
+	SeatBlockOther      SeatBlockReason = "other"
+)
+
+// SeatBlock withholds a seat from sale for a single session without touching
+// the hall layout.
+type SeatBlock struct {
+	Base
+	SessionID uint            `json:"session_id" gorm:"not null;uniqueIndex:idx_seat_blocks_session_seat"`
+	SeatID    uint            `json:"seat_id" gorm:"not null;uniqueIndex:idx_seat_blocks_session_seat"`
+	Reason    SeatBlockReason `json:"reason" gorm:"type:varchar(20);not null"`
+	Note      string          `json:"note,omitempty"`
+}
@@ -11,11 +11,62 @@ const (
 	SessionStatusCancelled SessionStatus = "cancelled"
 )
 
+type SessionFormat string
+
+const (
+	SessionFormat2D     SessionFormat = "2d"
+	SessionFormat3D     SessionFormat = "3d"
+	SessionFormatIMAX   SessionFormat = "imax"
+	SessionFormatIMAX3D SessionFormat = "imax_3d"
+)
+
+var SessionFormatSurcharges = map[SessionFormat]int{
+	SessionFormat2D:     0,
+	SessionFormat3D:     100,
+	SessionFormatIMAX:   200,
+	SessionFormatIMAX3D: 300,
+}
+
+const DolbyAtmosSurcharge = 50
+
 type Session struct {
 	Base
-	MovieID   uint          `json:"movie_id" gorm:"not null"`
-	HallID    uint          `json:"hall_id" gorm:"not null"`
-	StartTime time.Time     `json:"start_time" gorm:"not null"`
-	EndTime   time.Time     `json:"end_time" gorm:"not null"`
-	Status    SessionStatus `json:"status" gorm:"type:varchar(20);default:'scheduled'"`
+	MovieID    uint          `json:"movie_id" gorm:"not null"`
+	HallID     uint          `json:"hall_id" gorm:"not null"`
+	StartTime  time.Time     `json:"start_time" gorm:"not null"`
+	EndTime    time.Time     `json:"end_time" gorm:"not null"`
+	Status     SessionStatus `json:"status" gorm:"type:varchar(20);default:'scheduled'"`
+	TemplateID *uint         `json:"template_id,omitempty" gorm:"index"`
+
+	Format           SessionFormat `json:"format" gorm:"type:varchar(10);not null;default:'2d'"`
+	DolbyAtmos       bool          `json:"dolby_atmos" gorm:"not null;default:false"`
+	AudioLanguage    string        `json:"audio_language,omitempty" gorm:"type:varchar(3)"`
+	SubtitleLanguage string        `json:"subtitle_language,omitempty" gorm:"type:varchar(3)"`
+
+	// Ticket sales window. Without SalesOpenAt sales are open from creation;
+	// members may book from PresaleOpenAt until sales open to everyone.
+	SalesOpenAt   *time.Time `json:"sales_open_at,omitempty"`
+	SalesCloseAt  *time.Time `json:"sales_close_at,omitempty"`
+	PresaleOpenAt *time.Time `json:"presale_open_at,omitempty"`
+}
+
+var sessionStatusTransitions = map[SessionStatus][]SessionStatus{
+	SessionStatusScheduled: {SessionStatusOngoing, SessionStatusFinished, SessionStatusCancelled},
+	SessionStatusOngoing:   {SessionStatusFinished, SessionStatusCancelled},
+}
+
+// CanTransitionTo reports whether a session may move from s to next.
+// Finished and cancelled sessions are terminal.
+func (s SessionStatus) CanTransitionTo(next SessionStatus) bool {
+	if s == next {
+		return true
+	}
+
+	for _, allowed := range sessionStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+
+	return false
 }
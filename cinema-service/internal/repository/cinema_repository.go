@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"cinema-service/internal/models"
+	"errors"
+	"log/slog"
+
+	"gorm.io/gorm"
+)
+
+type CinemaRepository interface {
+	Create(*models.Cinema) error
+	List() ([]models.Cinema, error)
+	GetById(id uint) (*models.Cinema, error)
+	GetByName(name string) (*models.Cinema, error)
+	Update(id uint, cinema *models.Cinema) error
+	Delete(id uint) error
+	CountHalls(id uint) (int64, error)
+	CountOrphanHalls() (int64, error)
+	AssignOrphanHalls(id uint) (int64, error)
+}
+
+type cinemaRepository struct {
+	db     *gorm.DB
+	logger *slog.Logger
+}
+
+func NewCinemaRepository(db *gorm.DB, logger *slog.Logger) CinemaRepository {
+	return &cinemaRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *cinemaRepository) Create(cinema *models.Cinema) error {
+	if cinema == nil {
+		r.logger.Warn("attempt to create nil cinema")
+		return errors.New("cinema is nil")
+	}
+	if err := r.db.Create(cinema).Error; err != nil {
+		r.logger.Error("failed to create cinema", "err", err)
+		return err
+	}
+	return nil
+}
+
+func (r *cinemaRepository) List() ([]models.Cinema, error) {
+	var cinemas []models.Cinema
+	if err := r.db.Order("id").Find(&cinemas).Error; err != nil {
+		r.logger.Error("failed to fetch cinemas", "err", err)
+		return nil, err
+	}
+	return cinemas, nil
+}
+
+func (r *cinemaRepository) GetById(id uint) (*models.Cinema, error) {
+	var cinema models.Cinema
+	if err := r.db.Preload("Halls").First(&cinema, id).Error; err != nil {
+		r.logger.Error("failed to fetch cinema by id", "id", id, "err", err)
+		return nil, err
+	}
+	return &cinema, nil
+}
+
+func (r *cinemaRepository) GetByName(name string) (*models.Cinema, error) {
+	var cinema models.Cinema
+	if err := r.db.Where("name = ?", name).First(&cinema).Error; err != nil {
+		return nil, err
+	}
+	return &cinema, nil
+}
+
+func (r *cinemaRepository) Update(id uint, cinema *models.Cinema) error {
+	if cinema == nil {
+		return errors.New("cinema is nil")
+	}
+	if err := r.db.Model(&models.Cinema{}).
+		Where("id = ?", id).
+		Select("name", "address", "timezone", "opening_hours", "sales_open_days", "sales_close_minutes").
+		Updates(cinema).Error; err != nil {
+		r.logger.Error("failed to update cinema", "id", id, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (r *cinemaRepository) Delete(id uint) error {
+	if err := r.db.Delete(&models.Cinema{}, id).Error; err != nil {
+		r.logger.Error("failed to delete cinema", "id", id, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (r *cinemaRepository) CountHalls(id uint) (int64, error) {
+	var count int64
+	if err := r.db.Model(&models.Hall{}).Where("cinema_id = ?", id).Count(&count).Error; err != nil {
+		r.logger.Error("failed to count cinema halls", "id", id, "err", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *cinemaRepository) CountOrphanHalls() (int64, error) {
+	var count int64
+	if err := r.db.Model(&models.Hall{}).Where("cinema_id IS NULL").Count(&count).Error; err != nil {
+		r.logger.Error("failed to count halls without cinema", "err", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// AssignOrphanHalls moves halls created before venues existed into the cinema.
+func (r *cinemaRepository) AssignOrphanHalls(id uint) (int64, error) {
+	result := r.db.Model(&models.Hall{}).
+		Where("cinema_id IS NULL").
+		Update("cinema_id", id)
+	if result.Error != nil {
+		r.logger.Error("failed to assign halls to cinema", "id", id, "err", result.Error)
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
@@ -10,10 +10,12 @@ import (
 
 type HallRepository interface {
 	Create(*models.Hall) error
-	List() ([]models.Hall, error)
+	List(cinemaID uint, ids []uint) ([]models.Hall, error)
 	Update(id uint, hall *models.Hall) error
 	Delete(id uint) error
 	GetById(id uint) (*models.Hall, error)
+	ListByIDs(ids []uint) ([]models.Hall, error)
+	NumberExists(cinemaID uint, number int, excludeID uint) (bool, error)
 }
 
 type hallRepository struct {
@@ -40,9 +42,18 @@ func (r *hallRepository) Create(hall *models.Hall) error {
 	return nil
 }
 
-func (r *hallRepository) List() ([]models.Hall, error) {
+func (r *hallRepository) List(cinemaID uint, ids []uint) ([]models.Hall, error) {
 	var halls []models.Hall
-	if err := r.db.Preload("Seats").Find(&halls).Error; err != nil {
+
+	query := r.db.Preload("Seats")
+	if cinemaID != 0 {
+		query = query.Where("cinema_id = ?", cinemaID)
+	}
+	if len(ids) > 0 {
+		query = query.Where("id IN ?", ids)
+	}
+
+	if err := query.Find(&halls).Error; err != nil {
 		r.logger.Error("failed to fetch halls", "err", err)
 		return nil, err
 	}
@@ -56,6 +67,7 @@ func (r *hallRepository) Update(id uint, hall *models.Hall) error {
 	}
 	return r.db.Model(&models.Hall{}).
 		Where("id = ?", id).
+		Select("cinema_id", "number", "supports_3d", "supports_imax", "supports_dolby_atmos").
 		Updates(hall).Error
 }
 
@@ -76,3 +88,32 @@ func (r *hallRepository) Delete(id uint) error {
 	}
 	return nil
 }
+
+func (r *hallRepository) ListByIDs(ids []uint) ([]models.Hall, error) {
+	var halls []models.Hall
+
+	if len(ids) == 0 {
+		return halls, nil
+	}
+
+	if err := r.db.Where("id IN ?", ids).Find(&halls).Error; err != nil {
+		r.logger.Error("failed to fetch halls by ids", "err", err)
+		return nil, err
+	}
+	return halls, nil
+}
+
+func (r *hallRepository) NumberExists(cinemaID uint, number int, excludeID uint) (bool, error) {
+	var count int64
+
+	query := r.db.Model(&models.Hall{}).Where("cinema_id = ? AND number = ?", cinemaID, number)
+	if excludeID != 0 {
+		query = query.Where("id <> ?", excludeID)
+	}
+
+	if err := query.Count(&count).Error; err != nil {
+		r.logger.Error("failed to check hall number", "cinema_id", cinemaID, "number", number, "err", err)
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"cinema-service/internal/models"
+	"errors"
+	"log/slog"
+
+	"gorm.io/gorm"
+)
+
+type HolidayRepository interface {
+	Create(*models.Holiday) error
+	List() ([]models.Holiday, error)
+	Delete(id uint) error
+	ExistsOn(date string) (bool, error)
+}
+
+type holidayRepository struct {
+	db     *gorm.DB
+	logger *slog.Logger
+}
+
+func NewHolidayRepository(db *gorm.DB, logger *slog.Logger) HolidayRepository {
+	return &holidayRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *holidayRepository) Create(holiday *models.Holiday) error {
+	if holiday == nil {
+		r.logger.Warn("attempt to create nil holiday")
+		return errors.New("holiday is nil")
+	}
+	if err := r.db.Create(holiday).Error; err != nil {
+		r.logger.Error("failed to create holiday", "err", err)
+		return err
+	}
+	return nil
+}
+
+func (r *holidayRepository) List() ([]models.Holiday, error) {
+	var holidays []models.Holiday
+	if err := r.db.Order("date").Find(&holidays).Error; err != nil {
+		r.logger.Error("failed to fetch holidays", "err", err)
+		return nil, err
+	}
+	return holidays, nil
+}
+
+func (r *holidayRepository) Delete(id uint) error {
+	result := r.db.Unscoped().Delete(&models.Holiday{}, id)
+	if result.Error != nil {
+		r.logger.Error("failed to delete holiday", "err", result.Error)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *holidayRepository) ExistsOn(date string) (bool, error) {
+	var count int64
+	if err := r.db.Model(&models.Holiday{}).Where("date = ?", date).Count(&count).Error; err != nil {
+		r.logger.Error("failed to check holiday", "date", date, "err", err)
+		return false, err
+	}
+	return count > 0, nil
+}
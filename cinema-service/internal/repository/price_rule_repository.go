@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"cinema-service/internal/models"
+	"errors"
+	"log/slog"
+
+	"gorm.io/gorm"
+)
+
+type PriceRuleRepository interface {
+	Create(*models.PriceRule) error
+	List() ([]models.PriceRule, error)
+	ListActive() ([]models.PriceRule, error)
+	Update(id uint, rule *models.PriceRule) error
+	Delete(id uint) error
+	GetById(id uint) (*models.PriceRule, error)
+}
+
+type priceRuleRepository struct {
+	db     *gorm.DB
+	logger *slog.Logger
+}
+
+func NewPriceRuleRepository(db *gorm.DB, logger *slog.Logger) PriceRuleRepository {
+	return &priceRuleRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *priceRuleRepository) Create(rule *models.PriceRule) error {
+	if rule == nil {
+		r.logger.Warn("attempt to create nil price rule")
+		return errors.New("price rule is nil")
+	}
+	if err := r.db.Create(rule).Error; err != nil {
+		r.logger.Error("failed to create price rule", "err", err)
+		return err
+	}
+	return nil
+}
+
+func (r *priceRuleRepository) List() ([]models.PriceRule, error) {
+	var rules []models.PriceRule
+	if err := r.db.Order("priority, id").Find(&rules).Error; err != nil {
+		r.logger.Error("failed to fetch price rules", "err", err)
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (r *priceRuleRepository) ListActive() ([]models.PriceRule, error) {
+	var rules []models.PriceRule
+	if err := r.db.Where("active = ?", true).Order("priority, id").Find(&rules).Error; err != nil {
+		r.logger.Error("failed to fetch active price rules", "err", err)
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (r *priceRuleRepository) Update(id uint, rule *models.PriceRule) error {
+	if rule == nil {
+		return errors.New("price rule is nil")
+	}
+	rule.ID = id
+	if err := r.db.Save(rule).Error; err != nil {
+		r.logger.Error("failed to update price rule", "id", id, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (r *priceRuleRepository) GetById(id uint) (*models.PriceRule, error) {
+	var rule models.PriceRule
+
+	if err := r.db.First(&rule, id).Error; err != nil {
+		r.logger.Error("failed to fetch price rule by id", "error", err, "id", id)
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (r *priceRuleRepository) Delete(id uint) error {
+	if err := r.db.Delete(&models.PriceRule{}, id).Error; err != nil {
+		r.logger.Error("failed to delete price rule", "err", err)
+		return err
+	}
+	return nil
+}
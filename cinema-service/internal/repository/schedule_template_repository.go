@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"cinema-service/internal/models"
+	"errors"
+	"log/slog"
+
+	"gorm.io/gorm"
+)
+
+type ScheduleTemplateRepository interface {
+	Create(*models.ScheduleTemplate) error
+	List() ([]models.ScheduleTemplate, error)
+	GetById(id uint) (*models.ScheduleTemplate, error)
+	Delete(id uint) error
+}
+
+type scheduleTemplateRepository struct {
+	db     *gorm.DB
+	logger *slog.Logger
+}
+
+func NewScheduleTemplateRepository(db *gorm.DB, logger *slog.Logger) ScheduleTemplateRepository {
+	return &scheduleTemplateRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *scheduleTemplateRepository) Create(template *models.ScheduleTemplate) error {
+	if template == nil {
+		r.logger.Warn("attempt to create nil schedule template")
+		return errors.New("schedule template is nil")
+	}
+	if err := r.db.Create(template).Error; err != nil {
+		r.logger.Error("failed to create schedule template", "err", err)
+		return err
+	}
+	return nil
+}
+
+func (r *scheduleTemplateRepository) List() ([]models.ScheduleTemplate, error) {
+	var templates []models.ScheduleTemplate
+	if err := r.db.Order("id").Find(&templates).Error; err != nil {
+		r.logger.Error("failed to fetch schedule templates", "err", err)
+		return nil, err
+	}
+	return templates, nil
+}
+
+func (r *scheduleTemplateRepository) GetById(id uint) (*models.ScheduleTemplate, error) {
+	var template models.ScheduleTemplate
+	if err := r.db.First(&template, id).Error; err != nil {
+		r.logger.Error("failed to fetch schedule template by id", "id", id, "err", err)
+		return nil, err
+	}
+	return &template, nil
+}
+
+// Delete removes the template and detaches the sessions it generated, so they
+// stay on the schedule as ordinary sessions.
+func (r *scheduleTemplateRepository) Delete(id uint) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Session{}).Where("template_id = ?", id).Update("template_id", nil).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.ScheduleTemplate{}, id).Error
+	})
+	if err != nil {
+		r.logger.Error("failed to delete schedule template", "id", id, "err", err)
+		return err
+	}
+	return nil
+}
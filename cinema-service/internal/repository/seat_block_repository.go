@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"cinema-service/internal/models"
+	"log/slog"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SeatBlockRepository interface {
+	CreateBatch(blocks []models.SeatBlock) error
+	ListBySessionID(sessionID uint) ([]models.SeatBlock, error)
+	Delete(sessionID, seatID uint) (bool, error)
+}
+
+type seatBlockRepository struct {
+	db     *gorm.DB
+	logger *slog.Logger
+}
+
+func NewSeatBlockRepository(db *gorm.DB, logger *slog.Logger) SeatBlockRepository {
+	return &seatBlockRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// CreateBatch blocks the seats, updating the reason of seats that are already
+// blocked for the session.
+func (r *seatBlockRepository) CreateBatch(blocks []models.SeatBlock) error {
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	if err := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "session_id"}, {Name: "seat_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"reason", "note", "updated_at"}),
+	}).Create(&blocks).Error; err != nil {
+		r.logger.Error("failed to create seat blocks", "err", err)
+		return err
+	}
+	return nil
+}
+
+func (r *seatBlockRepository) ListBySessionID(sessionID uint) ([]models.SeatBlock, error) {
+	var blocks []models.SeatBlock
+	if err := r.db.Where("session_id = ?", sessionID).Order("seat_id").Find(&blocks).Error; err != nil {
+		r.logger.Error("failed to fetch seat blocks", "session_id", sessionID, "err", err)
+		return nil, err
+	}
+	return blocks, nil
+}
+
+func (r *seatBlockRepository) Delete(sessionID, seatID uint) (bool, error) {
+	result := r.db.Unscoped().
+		Where("session_id = ? AND seat_id = ?", sessionID, seatID).
+		Delete(&models.SeatBlock{})
+	if result.Error != nil {
+		r.logger.Error("failed to delete seat block", "session_id", sessionID, "seat_id", seatID, "err", result.Error)
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"cinema-service/internal/models"
+	"log/slog"
+
+	"gorm.io/gorm"
+)
+
+type SeatGroupRepository interface {
+	Create(group *models.SeatGroup, seatIDs []uint) error
+	GetById(id uint) (*models.SeatGroup, error)
+	ListByHallID(hallID uint) ([]models.SeatGroup, error)
+	ListByIDs(ids []uint) ([]models.SeatGroup, error)
+	Delete(id uint) error
+}
+
+type seatGroupRepository struct {
+	db     *gorm.DB
+	logger *slog.Logger
+}
+
+func NewSeatGroupRepository(db *gorm.DB, logger *slog.Logger) SeatGroupRepository {
+	return &seatGroupRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create stores the group and moves the seats into it in one transaction.
+func (r *seatGroupRepository) Create(group *models.SeatGroup, seatIDs []uint) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Seats").Create(group).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.Seat{}).
+			Where("id IN ?", seatIDs).
+			Update("group_id", group.ID).Error
+	})
+	if err != nil {
+		r.logger.Error("failed to create seat group", "hall_id", group.HallID, "name", group.Name, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (r *seatGroupRepository) GetById(id uint) (*models.SeatGroup, error) {
+	var group models.SeatGroup
+
+	if err := r.db.
+		Preload("Seats", func(db *gorm.DB) *gorm.DB { return db.Order("row, number") }).
+		First(&group, id).Error; err != nil {
+		r.logger.Error("failed to fetch seat group by id", "id", id, "err", err)
+		return nil, err
+	}
+	return &group, nil
+}
+
+func (r *seatGroupRepository) ListByHallID(hallID uint) ([]models.SeatGroup, error) {
+	var groups []models.SeatGroup
+
+	if err := r.db.
+		Preload("Seats", func(db *gorm.DB) *gorm.DB { return db.Order("row, number") }).
+		Where("hall_id = ?", hallID).
+		Order("name").
+		Find(&groups).Error; err != nil {
+		r.logger.Error("failed to fetch seat groups by hall id", "hall_id", hallID, "err", err)
+		return nil, err
+	}
+	return groups, nil
+}
+
+func (r *seatGroupRepository) ListByIDs(ids []uint) ([]models.SeatGroup, error) {
+	var groups []models.SeatGroup
+	if len(ids) == 0 {
+		return groups, nil
+	}
+
+	if err := r.db.Where("id IN ?", ids).Find(&groups).Error; err != nil {
+		r.logger.Error("failed to fetch seat groups by ids", "err", err)
+		return nil, err
+	}
+	return groups, nil
+}
+
+// Delete removes the group and releases its seats.
+func (r *seatGroupRepository) Delete(id uint) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Seat{}).
+			Where("group_id = ?", id).
+			Update("group_id", nil).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&models.SeatGroup{}, id).Error
+	})
+	if err != nil {
+		r.logger.Error("failed to delete seat group", "id", id, "err", err)
+		return err
+	}
+	return nil
+}
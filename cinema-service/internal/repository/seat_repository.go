@@ -14,6 +14,9 @@ type SeatRepository interface {
 	Update(id uint, seat *models.Seat) error
 	Delete(id uint) error
 	GetById(id uint) (*models.Seat, error)
+	ListByHallID(hallID uint) ([]models.Seat, error)
+	ReplaceHallSeats(hallID uint, seats []models.Seat, guard func(unended []models.Session) error) error
+	CreateBatch(seats []models.Seat) error
 }
 
 type seatRepository struct {
@@ -56,6 +59,7 @@ func (r *seatRepository) Update(id uint, seat *models.Seat) error {
 
 	return r.db.Model(&models.Seat{}).
 		Where("id = ?", id).
+		Select("row", "number", "type", "x", "y", "section").
 		Updates(seat).Error
 }
 
@@ -76,3 +80,65 @@ func (r *seatRepository) Delete(id uint) error {
 	}
 	return nil
 }
+
+func (r *seatRepository) ListByHallID(hallID uint) ([]models.Seat, error) {
+	var seats []models.Seat
+
+	if err := r.db.
+		Where("hall_id = ?", hallID).
+		Order("row, number").
+		Find(&seats).Error; err != nil {
+		r.logger.Error("failed to fetch seats by hall id", "hall_id", hallID, "err", err)
+		return nil, err
+	}
+	return seats, nil
+}
+
+// ReplaceHallSeats swaps the whole layout of the hall. The guard gets the
+// sessions of the hall that have not ended and can refuse the replace; it runs
+// under the hall lock, so no session is added between the guard and the write.
+func (r *seatRepository) ReplaceHallSeats(hallID uint, seats []models.Seat, guard func(unended []models.Session) error) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockHall(tx, hallID); err != nil {
+			return err
+		}
+		unended, err := unendedHallSessions(tx, hallID)
+		if err != nil {
+			return err
+		}
+		if err := guard(unended); err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Where("hall_id = ?", hallID).Delete(&models.Seat{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("hall_id = ?", hallID).Delete(&models.SeatGroup{}).Error; err != nil {
+			return err
+		}
+		if len(seats) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(&seats, 200).Error
+	})
+	if err != nil {
+		r.logger.Error("failed to replace hall seats", "hall_id", hallID, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (r *seatRepository) CreateBatch(seats []models.Seat) error {
+	if len(seats) == 0 {
+		return nil
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		return tx.CreateInBatches(&seats, 200).Error
+	})
+	if err != nil {
+		r.logger.Error("failed to create seats batch", "count", len(seats), "err", err)
+		return err
+	}
+	return nil
+}
@@ -4,17 +4,50 @@ import (
 	"cinema-service/internal/models"
 	"errors"
 	"log/slog"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+type SessionFilter struct {
+	IDs      []uint
+	CinemaID uint
+	Timezone string
+	From     *time.Time
+	To       *time.Time
+	MovieID  uint
+	HallID   uint
+	Status   string
+	TimeFrom string
+	TimeTo   string
+
+	Format           string
+	DolbyAtmos       *bool
+	AudioLanguage    string
+	SubtitleLanguage string
+
+	OrderBy string
+	Limit   int
+	Offset  int
+}
+
 type SessionRepository interface {
 	Create(*models.Session) error
 	List() ([]models.Session, error)
+	Search(filter SessionFilter) ([]models.Session, int64, error)
 	Update(id uint, session *models.Session) error
 	Delete(id uint) error
 	GetById(id uint) (*models.Session, error)
 	ListByMovieID(movieID uint) ([]models.Session, error)
+	ListUnendedByHallID(hallID uint) ([]models.Session, error)
+	FindOverlapping(hallID uint, from, to time.Time, excludeID uint) ([]models.Session, error)
+	CreateInFreeSlot(session *models.Session, from, to time.Time) ([]uint, error)
+	UpdateInFreeSlot(id uint, session *models.Session, from, to time.Time) ([]uint, error)
+	ListDueForStatusChange(now time.Time) ([]models.Session, error)
+	ListUpcomingByTemplateID(templateID uint, after time.Time) ([]models.Session, error)
+	UpdateStatus(id uint, from, to models.SessionStatus) (bool, error)
+	ListIDsByHallID(hallID uint) ([]uint, error)
 }
 
 type sessionRepository struct {
@@ -54,16 +87,89 @@ func (r *sessionRepository) List() ([]models.Session, error) {
 	return sessions, nil
 }
 
+// Search applies the filter and returns one page of sessions together with the
+// total number of matching rows. Time of day is compared against start_time in
+// the filter's time zone, or the database one when it is empty.
+func (r *sessionRepository) Search(filter SessionFilter) ([]models.Session, int64, error) {
+	var sessions []models.Session
+	var total int64
+
+	query := r.db.Model(&models.Session{})
+	if len(filter.IDs) > 0 {
+		query = query.Where("id IN ?", filter.IDs)
+	}
+	if filter.From != nil {
+		query = query.Where("start_time >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("start_time < ?", *filter.To)
+	}
+	if filter.CinemaID != 0 {
+		query = query.Where("hall_id IN (?)", r.db.Model(&models.Hall{}).Select("id").Where("cinema_id = ?", filter.CinemaID))
+	}
+	if filter.MovieID != 0 {
+		query = query.Where("movie_id = ?", filter.MovieID)
+	}
+	if filter.HallID != 0 {
+		query = query.Where("hall_id = ?", filter.HallID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Format != "" {
+		query = query.Where("format = ?", filter.Format)
+	}
+	if filter.DolbyAtmos != nil {
+		query = query.Where("dolby_atmos = ?", *filter.DolbyAtmos)
+	}
+	if filter.AudioLanguage != "" {
+		query = query.Where("audio_language = ?", filter.AudioLanguage)
+	}
+	if filter.SubtitleLanguage != "" {
+		query = query.Where("subtitle_language = ?", filter.SubtitleLanguage)
+	}
+	if filter.TimeFrom != "" || filter.TimeTo != "" {
+		clock := "CAST(start_time AS time)"
+		args := []interface{}{}
+		if filter.Timezone != "" {
+			clock = "CAST(start_time AT TIME ZONE ? AS time)"
+			args = append(args, filter.Timezone)
+		}
+		if filter.TimeFrom != "" {
+			query = query.Where(clock+" >= CAST(? AS time)", append(args, filter.TimeFrom)...)
+		}
+		if filter.TimeTo != "" {
+			query = query.Where(clock+" < CAST(? AS time)", append(args, filter.TimeTo)...)
+		}
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		r.logger.Error("failed to count sessions", "err", err)
+		return nil, 0, err
+	}
+
+	if filter.OrderBy != "" {
+		query = query.Order(filter.OrderBy)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit).Offset(filter.Offset)
+	}
+
+	if err := query.Find(&sessions).Error; err != nil {
+		r.logger.Error("failed to search sessions", "err", err)
+		return nil, 0, err
+	}
+
+	return sessions, total, nil
+}
+
 func (r *sessionRepository) Update(id uint, session *models.Session) error {
 	if session == nil {
 		r.logger.Warn("attempt to update nil session")
 		return errors.New("session is nil")
 	}
 
-	if err := r.db.
-		Model(&models.Session{}).
-		Where("id = ?", id).
-		Updates(session).Error; err != nil {
+	if err := updateSession(r.db, id, session); err != nil {
 
 		r.logger.Error(
 			"failed to update session",
@@ -76,6 +182,15 @@ func (r *sessionRepository) Update(id uint, session *models.Session) error {
 	return nil
 }
 
+func updateSession(db *gorm.DB, id uint, session *models.Session) error {
+	return db.
+		Model(&models.Session{}).
+		Where("id = ?", id).
+		Select("start_time", "end_time", "status", "format", "dolby_atmos", "audio_language", "subtitle_language",
+			"sales_open_at", "sales_close_at", "presale_open_at").
+		Updates(session).Error
+}
+
 func (r *sessionRepository) Delete(id uint) error {
 	if err := r.db.Delete(&models.Session{}, id).Error; err != nil {
 		r.logger.Error(
@@ -121,3 +236,198 @@ func (r *sessionRepository) ListByMovieID(movieID uint) ([]models.Session, error
 
 	return sessions, nil
 }
+
+// ListUnendedByHallID returns the sessions of the hall that have not ended
+// yet, whatever their status.
+func (r *sessionRepository) ListUnendedByHallID(hallID uint) ([]models.Session, error) {
+	sessions, err := unendedHallSessions(r.db, hallID)
+	if err != nil {
+		r.logger.Error(
+			"failed to fetch unended sessions",
+			"hall_id", hallID,
+			"err", err,
+		)
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+func unendedHallSessions(db *gorm.DB, hallID uint) ([]models.Session, error) {
+	var sessions []models.Session
+	err := db.
+		Select("id", "status").
+		Where("hall_id = ? AND end_time > ?", hallID, time.Now()).
+		Find(&sessions).Error
+	return sessions, err
+}
+
+// FindOverlapping returns the active sessions of the hall that intersect the
+// [from, to) interval. Cancelled sessions never block the hall.
+func (r *sessionRepository) FindOverlapping(hallID uint, from, to time.Time, excludeID uint) ([]models.Session, error) {
+	var sessions []models.Session
+
+	query := r.db.
+		Where("hall_id = ? AND status <> ?", hallID, models.SessionStatusCancelled).
+		Where("start_time < ? AND end_time > ?", to, from)
+	if excludeID != 0 {
+		query = query.Where("id <> ?", excludeID)
+	}
+
+	if err := query.Order("start_time").Find(&sessions).Error; err != nil {
+		r.logger.Error(
+			"failed to find overlapping sessions",
+			"hall_id", hallID,
+			"err", err,
+		)
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// CreateInFreeSlot creates the session unless another active session of the
+// hall intersects [from, to), in which case the conflicting ids are returned
+// and nothing is written. The hall row stays locked from the check to the
+// insert, so two requests for the same hall cannot both pass the check.
+func (r *sessionRepository) CreateInFreeSlot(session *models.Session, from, to time.Time) ([]uint, error) {
+	var conflicts []uint
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		conflicts, err = lockHallOverlaps(tx, session.HallID, from, to, 0)
+		if err != nil || len(conflicts) > 0 {
+			return err
+		}
+		return tx.Create(session).Error
+	})
+	if err != nil {
+		r.logger.Error("failed to create session", "hall_id", session.HallID, "err", err)
+		return nil, err
+	}
+
+	return conflicts, nil
+}
+
+// UpdateInFreeSlot is the update counterpart of CreateInFreeSlot.
+func (r *sessionRepository) UpdateInFreeSlot(id uint, session *models.Session, from, to time.Time) ([]uint, error) {
+	var conflicts []uint
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		conflicts, err = lockHallOverlaps(tx, session.HallID, from, to, id)
+		if err != nil || len(conflicts) > 0 {
+			return err
+		}
+		return updateSession(tx, id, session)
+	})
+	if err != nil {
+		r.logger.Error("failed to update session", "id", id, "hall_id", session.HallID, "err", err)
+		return nil, err
+	}
+
+	return conflicts, nil
+}
+
+// lockHall holds the hall row until the transaction ends. Session writes and
+// layout replaces of the hall take this lock, so they run one at a time.
+func lockHall(tx *gorm.DB, hallID uint) error {
+	var hall models.Hall
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&hall, hallID).Error
+}
+
+func lockHallOverlaps(tx *gorm.DB, hallID uint, from, to time.Time, excludeID uint) ([]uint, error) {
+	if err := lockHall(tx, hallID); err != nil {
+		return nil, err
+	}
+
+	query := tx.Model(&models.Session{}).
+		Where("hall_id = ? AND status <> ?", hallID, models.SessionStatusCancelled).
+		Where("start_time < ? AND end_time > ?", to, from)
+	if excludeID != 0 {
+		query = query.Where("id <> ?", excludeID)
+	}
+
+	var ids []uint
+	if err := query.Order("start_time").Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// ListDueForStatusChange returns scheduled sessions that have already started
+// and ongoing sessions that have already ended.
+func (r *sessionRepository) ListDueForStatusChange(now time.Time) ([]models.Session, error) {
+	var sessions []models.Session
+
+	if err := r.db.
+		Where("(status = ? AND start_time <= ?) OR (status = ? AND end_time <= ?)",
+			models.SessionStatusScheduled, now, models.SessionStatusOngoing, now).
+		Order("start_time").
+		Find(&sessions).Error; err != nil {
+
+		r.logger.Error("failed to fetch sessions due for status change", "err", err)
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// UpdateStatus moves the session to the new status only if it is still in the
+// expected one, so a concurrent manual change is never overwritten.
+func (r *sessionRepository) UpdateStatus(id uint, from, to models.SessionStatus) (bool, error) {
+	result := r.db.
+		Model(&models.Session{}).
+		Where("id = ? AND status = ?", id, from).
+		Update("status", to)
+	if result.Error != nil {
+		r.logger.Error(
+			"failed to update session status",
+			"id", id,
+			"from", from,
+			"to", to,
+			"err", result.Error,
+		)
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *sessionRepository) ListUpcomingByTemplateID(templateID uint, after time.Time) ([]models.Session, error) {
+	var sessions []models.Session
+
+	if err := r.db.
+		Where("template_id = ? AND start_time > ?", templateID, after).
+		Order("start_time").
+		Find(&sessions).Error; err != nil {
+
+		r.logger.Error(
+			"failed to fetch sessions by template id",
+			"template_id", templateID,
+			"err", err,
+		)
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+func (r *sessionRepository) ListIDsByHallID(hallID uint) ([]uint, error) {
+	var ids []uint
+
+	if err := r.db.
+		Model(&models.Session{}).
+		Where("hall_id = ?", hallID).
+		Pluck("id", &ids).Error; err != nil {
+
+		r.logger.Error(
+			"failed to fetch session ids by hall id",
+			"hall_id", hallID,
+			"err", err,
+		)
+		return nil, err
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"cinema-service/internal/clients"
+	"cinema-service/internal/constants"
+	"cinema-service/internal/infrastructure"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// checkActiveBookings fails with ErrActiveBookings while booking-service still
+// holds pending or confirmed bookings for the sessions or seats. The ids are
+// sent in batches of MaxBatchIDs to keep the query string bounded.
+func checkActiveBookings(sessionIDs, seatIDs []uint) error {
+	var count int
+	for batch := range slices.Chunk(sessionIDs, constants.MaxBatchIDs) {
+		n, err := clients.CountActiveBookings(batch, nil)
+		if err != nil {
+			return fmt.Errorf("failed to check active bookings: %w", err)
+		}
+		count += n
+	}
+	for batch := range slices.Chunk(seatIDs, constants.MaxBatchIDs) {
+		n, err := clients.CountActiveBookings(nil, batch)
+		if err != nil {
+			return fmt.Errorf("failed to check active bookings: %w", err)
+		}
+		count += n
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: %d", constants.ErrActiveBookings, count)
+	}
+	return nil
+}
+
+// clearActiveBookings refuses a deletion while there are active bookings for
+// the sessions or seats, unless force is set. With force the bookings are
+// revoked before anything is deleted: if the revoke event cannot be published
+// the deletion has to be aborted, otherwise the bookings would stay paid for
+// seats that no longer exist.
+func clearActiveBookings(events infrastructure.SessionEventPublisher, reason string, sessionIDs, seatIDs []uint, force bool) error {
+	err := checkActiveBookings(sessionIDs, seatIDs)
+	if !force || !errors.Is(err, constants.ErrActiveBookings) {
+		return err
+	}
+	if err := events.PublishBookingsRevoked(reason, sessionIDs, seatIDs); err != nil {
+		return fmt.Errorf("failed to revoke active bookings: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package services
+
+import (
+	"cinema-service/internal/constants"
+	"cinema-service/internal/dto"
+	"cinema-service/internal/models"
+	"cinema-service/internal/repository"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type CinemaService interface {
+	Create(req dto.CreateCinemaRequest) (*models.Cinema, error)
+	List() ([]models.Cinema, error)
+	GetById(id uint) (*models.Cinema, error)
+	Update(id uint, req dto.UpdateCinemaRequest) (*models.Cinema, error)
+	Delete(id uint) error
+	EnsureDefaultCinema() error
+}
+
+type cinemaService struct {
+	cinemaRepo repository.CinemaRepository
+	logger     *slog.Logger
+}
+
+func NewCinemaService(cinemaRepo repository.CinemaRepository, logger *slog.Logger) CinemaService {
+	return &cinemaService{
+		cinemaRepo: cinemaRepo,
+		logger:     logger,
+	}
+}
+
+func (s *cinemaService) Create(req dto.CreateCinemaRequest) (*models.Cinema, error) {
+
+	cinema := &models.Cinema{
+		Name:         req.Name,
+		Address:      req.Address,
+		Timezone:     req.Timezone,
+		OpeningHours: req.OpeningHours,
+
+		SalesOpenDays:     req.SalesOpenDays,
+		SalesCloseMinutes: req.SalesCloseMinutes,
+	}
+	if cinema.Timezone == "" {
+		cinema.Timezone = constants.DefaultCinemaTimezone
+	}
+
+	if err := validateCinema(cinema); err != nil {
+		return nil, err
+	}
+
+	if err := s.cinemaRepo.Create(cinema); err != nil {
+		s.logger.Error("failed to create cinema", "name", req.Name, "err", err)
+		return nil, err
+	}
+
+	return cinema, nil
+}
+
+func (s *cinemaService) List() ([]models.Cinema, error) {
+	return s.cinemaRepo.List()
+}
+
+func (s *cinemaService) GetById(id uint) (*models.Cinema, error) {
+	return s.cinemaRepo.GetById(id)
+}
+
+func (s *cinemaService) Update(id uint, req dto.UpdateCinemaRequest) (*models.Cinema, error) {
+
+	cinema, err := s.cinemaRepo.GetById(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		cinema.Name = *req.Name
+	}
+	if req.Address != nil {
+		cinema.Address = *req.Address
+	}
+	if req.Timezone != nil {
+		cinema.Timezone = *req.Timezone
+	}
+	if req.OpeningHours != nil {
+		cinema.OpeningHours = req.OpeningHours
+	}
+	if req.SalesOpenDays != nil {
+		cinema.SalesOpenDays = *req.SalesOpenDays
+	}
+	if req.SalesCloseMinutes != nil {
+		cinema.SalesCloseMinutes = *req.SalesCloseMinutes
+	}
+
+	if err := validateCinema(cinema); err != nil {
+		return nil, err
+	}
+
+	if err := s.cinemaRepo.Update(id, cinema); err != nil {
+		return nil, err
+	}
+
+	return cinema, nil
+}
+
+func (s *cinemaService) Delete(id uint) error {
+
+	if _, err := s.cinemaRepo.GetById(id); err != nil {
+		return err
+	}
+
+	halls, err := s.cinemaRepo.CountHalls(id)
+	if err != nil {
+		return err
+	}
+	if halls > 0 {
+		return constants.ErrCinemaHasHalls
+	}
+
+	return s.cinemaRepo.Delete(id)
+}
+
+// EnsureDefaultCinema puts halls created before venues were introduced into a
+// default cinema, so every hall belongs to exactly one venue.
+func (s *cinemaService) EnsureDefaultCinema() error {
+
+	orphans, err := s.cinemaRepo.CountOrphanHalls()
+	if err != nil || orphans == 0 {
+		return err
+	}
+
+	cinema, err := s.cinemaRepo.GetByName(constants.DefaultCinemaName)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		cinema = &models.Cinema{
+			Name:     constants.DefaultCinemaName,
+			Timezone: constants.DefaultCinemaTimezone,
+		}
+		err = s.cinemaRepo.Create(cinema)
+	}
+	if err != nil {
+		return err
+	}
+
+	assigned, err := s.cinemaRepo.AssignOrphanHalls(cinema.ID)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("halls assigned to default cinema", "cinema_id", cinema.ID, "halls", assigned)
+	return nil
+}
+
+func validateCinema(cinema *models.Cinema) error {
+	if cinema.Name == "" {
+		return errors.New("name is required")
+	}
+	if _, err := time.LoadLocation(cinema.Timezone); err != nil {
+		return fmt.Errorf("unknown timezone %q", cinema.Timezone)
+	}
+
+	for day, period := range cinema.OpeningHours {
+		if !isWeekdayName(day) {
+			return fmt.Errorf("opening_hours: unknown weekday %q", day)
+		}
+		for _, value := range []string{period.Open, period.Close} {
+			if _, err := time.Parse(timeOfDayLayout, value); err != nil {
+				return fmt.Errorf("opening_hours: %s times must be in HH:MM format", day)
+			}
+		}
+	}
+
+	return nil
+}
+
+func isWeekdayName(name string) bool {
+	for _, weekday := range weekdayNames {
+		if weekday == name {
+			return true
+		}
+	}
+	return false
+}
+
+// cinemaLocation returns the venue's time zone. The timezone is validated on
+// write, so the UTC fallback only covers rows edited by hand.
+func cinemaLocation(cinema *models.Cinema) *time.Location {
+	location, err := time.LoadLocation(cinema.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// hallLocation resolves the time zone of the venue the hall belongs to.
+func hallLocation(cinemaRepo repository.CinemaRepository, hall *models.Hall) (*time.Location, error) {
+	cinema, err := hallCinema(cinemaRepo, hall)
+	if err != nil {
+		return nil, err
+	}
+	if cinema == nil {
+		return time.Local, nil
+	}
+	return cinemaLocation(cinema), nil
+}
+
+// hallCinema loads the venue of the hall, or nil for halls without one.
+func hallCinema(cinemaRepo repository.CinemaRepository, hall *models.Hall) (*models.Cinema, error) {
+	if hall.CinemaID == nil {
+		return nil, nil
+	}
+	return cinemaRepo.GetById(*hall.CinemaID)
+}
+
+// openingWindow returns the opening period of the venue on the given day in
+// the venue's time zone. ok is false when no hours are configured for that day.
+func openingWindow(cinema *models.Cinema, day time.Time) (time.Time, time.Time, bool) {
+	period, found := cinema.OpeningHours[weekdayNames[day.Weekday()]]
+	if !found {
+		return time.Time{}, time.Time{}, false
+	}
+
+	opensAt, err := time.Parse(timeOfDayLayout, period.Open)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	closesAt, err := time.Parse(timeOfDayLayout, period.Close)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	from := time.Date(day.Year(), day.Month(), day.Day(), opensAt.Hour(), opensAt.Minute(), 0, 0, day.Location())
+	to := time.Date(day.Year(), day.Month(), day.Day(), closesAt.Hour(), closesAt.Minute(), 0, 0, day.Location())
+	if !to.After(from) {
+		to = to.AddDate(0, 0, 1)
+	}
+
+	return from, to, true
+}
@@ -1,9 +1,12 @@
 package services
 
 import (
+	"cinema-service/internal/constants"
 	"cinema-service/internal/dto"
+	"cinema-service/internal/infrastructure"
 	"cinema-service/internal/models"
 	"cinema-service/internal/repository"
+	"errors"
 	"log/slog"
 
 	"gorm.io/gorm"
@@ -11,24 +14,33 @@ import (
 
 type HallService interface {
 	CreateHall(req dto.CreateHallRequest) (*models.Hall, error)
-	ListHall() ([]models.Hall, error)
+	ListHall(query dto.HallListQuery) ([]models.Hall, error)
 	UpdateHall(id uint, req dto.UpdateHallRequest) (*models.Hall, error)
 	GetHallByID(id uint) (*models.Hall, error)
-	DeleteHall(id uint) error
+	DeleteHall(id uint, force bool) error
 }
 
 type hallService struct {
-	hallRepo repository.HallRepository
-	logger   *slog.Logger
+	hallRepo    repository.HallRepository
+	cinemaRepo  repository.CinemaRepository
+	sessionRepo repository.SessionRepository
+	events      infrastructure.SessionEventPublisher
+	logger      *slog.Logger
 }
 
 func NewHallService(
 	hallRepo repository.HallRepository,
+	cinemaRepo repository.CinemaRepository,
+	sessionRepo repository.SessionRepository,
+	events infrastructure.SessionEventPublisher,
 	logger *slog.Logger,
 ) HallService {
 	return &hallService{
-		hallRepo: hallRepo,
-		logger:   logger,
+		hallRepo:    hallRepo,
+		cinemaRepo:  cinemaRepo,
+		sessionRepo: sessionRepo,
+		events:      events,
+		logger:      logger,
 	}
 }
 
@@ -42,9 +54,27 @@ func (s *hallService) UpdateHall(id uint, req dto.UpdateHallRequest) (*models.Ha
 		return nil, gorm.ErrRecordNotFound
 	}
 
+	if req.CinemaID != nil {
+		hall.CinemaID = req.CinemaID
+	}
 	if req.Number != nil {
 		hall.Number = *req.Number
 	}
+	if req.Supports3D != nil {
+		hall.Supports3D = *req.Supports3D
+	}
+	if req.SupportsIMAX != nil {
+		hall.SupportsIMAX = *req.SupportsIMAX
+	}
+	if req.SupportsDolbyAtmos != nil {
+		hall.SupportsDolbyAtmos = *req.SupportsDolbyAtmos
+	}
+
+	if hall.CinemaID != nil && (req.CinemaID != nil || req.Number != nil) {
+		if err := s.checkHallNumber(*hall.CinemaID, hall.Number, hall.ID); err != nil {
+			return nil, err
+		}
+	}
 
 	if err := s.hallRepo.Update(id, hall); err != nil {
 		return nil, err
@@ -53,8 +83,17 @@ func (s *hallService) UpdateHall(id uint, req dto.UpdateHallRequest) (*models.Ha
 }
 
 func (s *hallService) CreateHall(req dto.CreateHallRequest) (*models.Hall, error) {
+	if err := s.checkHallNumber(req.CinemaID, req.Number, 0); err != nil {
+		return nil, err
+	}
+
 	hall := models.Hall{
-		Number: req.Number,
+		CinemaID: &req.CinemaID,
+		Number:   req.Number,
+
+		Supports3D:         req.Supports3D,
+		SupportsIMAX:       req.SupportsIMAX,
+		SupportsDolbyAtmos: req.SupportsDolbyAtmos,
 	}
 	if err := s.hallRepo.Create(&hall); err != nil {
 		s.logger.Error("service: failed to create hall", "err", err)
@@ -63,8 +102,13 @@ func (s *hallService) CreateHall(req dto.CreateHallRequest) (*models.Hall, error
 	return &hall, nil
 }
 
-func (s *hallService) ListHall() ([]models.Hall, error) {
-	halls, err := s.hallRepo.List()
+func (s *hallService) ListHall(query dto.HallListQuery) ([]models.Hall, error) {
+	ids, err := parseIDList(query.IDs)
+	if err != nil {
+		return nil, err
+	}
+
+	halls, err := s.hallRepo.List(query.CinemaID, ids)
 	if err != nil {
 		s.logger.Error("service: failed to list halls", "err", err)
 		return nil, err
@@ -84,12 +128,43 @@ func (s *hallService) GetHallByID(id uint) (*models.Hall, error) {
 	return hall, nil
 }
 
-func (s *hallService) DeleteHall(id uint) error {
-	err := s.hallRepo.Delete(id)
+func (s *hallService) DeleteHall(id uint, force bool) error {
+	if _, err := s.hallRepo.GetById(id); err != nil {
+		return err
+	}
+
+	sessionIDs, err := s.sessionRepo.ListIDsByHallID(id)
 	if err != nil {
+		return err
+	}
+	if err := clearActiveBookings(s.events, constants.RevokeReasonHallDeleted, sessionIDs, nil, force); err != nil {
+		return err
+	}
+
+	if err := s.hallRepo.Delete(id); err != nil {
 		s.logger.Error("failed to delete hall", "id", id)
 		return err
 	}
 	s.logger.Info("hall deleted successfully", "id", id)
 	return nil
 }
+
+// checkHallNumber makes sure the cinema exists and does not already have a
+// hall with this number.
+func (s *hallService) checkHallNumber(cinemaID uint, number int, excludeID uint) error {
+	if _, err := s.cinemaRepo.GetById(cinemaID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ErrCinemaNotFound
+		}
+		return err
+	}
+
+	exists, err := s.hallRepo.NumberExists(cinemaID, number, excludeID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return constants.ErrHallNumberTaken
+	}
+	return nil
+}
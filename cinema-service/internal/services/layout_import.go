@@ -0,0 +1,164 @@
+package services
+
+import (
+	"cinema-service/internal/dto"
+	"cinema-service/internal/models"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	LayoutModeAppend  = "append"
+	LayoutModeReplace = "replace"
+)
+
+var LayoutCSVHeader = []string{"row", "number", "type", "x", "y", "section"}
+
+// ParseLayoutCSV reads row,number,type,x,y,section records; everything after
+// the seat number is optional. The header line is optional and malformed
+// records are returned with an error instead of failing the whole file.
+func ParseLayoutCSV(r io.Reader) ([]dto.SeatImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var rows []dto.SeatImportRow
+	line := 0
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line++
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			rows = append(rows, dto.SeatImportRow{Line: line, Error: parseErr.Err.Error()})
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), LayoutCSVHeader[0]) {
+			continue
+		}
+
+		row := dto.SeatImportRow{Line: line}
+		if len(record) < 2 || len(record) > len(LayoutCSVHeader) {
+			row.Error = "expected row,number[,type,x,y,section]"
+			rows = append(rows, row)
+			continue
+		}
+
+		if row.Row, err = strconv.Atoi(strings.TrimSpace(record[0])); err != nil {
+			row.Error = "row must be a number"
+		} else if row.Number, err = strconv.Atoi(strings.TrimSpace(record[1])); err != nil {
+			row.Error = "number must be a number"
+		}
+		if len(record) >= 3 {
+			row.Type = models.SeatType(strings.ToLower(strings.TrimSpace(record[2])))
+		}
+		if len(record) >= 4 && row.Error == "" {
+			if row.X, err = parseCoordinate(record[3]); err != nil {
+				row.Error = "x must be a number"
+			}
+		}
+		if len(record) >= 5 && row.Error == "" {
+			if row.Y, err = parseCoordinate(record[4]); err != nil {
+				row.Error = "y must be a number"
+			}
+		}
+		if len(record) == 6 {
+			row.Section = strings.TrimSpace(record[5])
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func ParseLayoutJSON(r io.Reader) ([]dto.SeatImportRow, error) {
+	var seats []dto.CreateSeatRequest
+	if err := json.NewDecoder(r).Decode(&seats); err != nil {
+		return nil, err
+	}
+
+	rows := make([]dto.SeatImportRow, 0, len(seats))
+	for i, seat := range seats {
+		rows = append(rows, dto.SeatImportRow{
+			Line:    i + 1,
+			Row:     seat.Row,
+			Number:  seat.Number,
+			Type:    seat.Type,
+			X:       seat.X,
+			Y:       seat.Y,
+			Section: seat.Section,
+		})
+	}
+
+	return rows, nil
+}
+
+// parseCoordinate treats an empty cell as a seat without coordinates.
+func parseCoordinate(value string) (*float64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	coordinate, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &coordinate, nil
+}
+
+// validateImportRows marks every row that cannot be stored under the
+// (hall_id, row, number) unique index: duplicates inside the file and, in
+// append mode, seats that already exist in the hall.
+func validateImportRows(rows []dto.SeatImportRow, existing []models.Seat) []dto.SeatImportRow {
+	taken := make(map[[2]int]int, len(existing)+len(rows))
+	for _, seat := range existing {
+		taken[[2]int{seat.Row, seat.Number}] = 0
+	}
+
+	for i := range rows {
+		row := &rows[i]
+		if row.Error != "" {
+			continue
+		}
+
+		switch {
+		case row.Row < 1 || row.Number < 1:
+			row.Error = "row and number must be positive"
+			continue
+		case len(row.Section) > 50:
+			row.Error = "section must be at most 50 characters"
+			continue
+		case row.Type == "":
+			row.Type = models.SeatTypeStandard
+		}
+		if _, ok := models.SeatTypePrices[row.Type]; !ok {
+			row.Error = fmt.Sprintf("unknown seat type %q", row.Type)
+			continue
+		}
+
+		key := [2]int{row.Row, row.Number}
+		if line, ok := taken[key]; ok {
+			if line == 0 {
+				row.Error = fmt.Sprintf("seat %d-%d already exists in the hall", row.Row, row.Number)
+			} else {
+				row.Error = fmt.Sprintf("seat %d-%d duplicates line %d", row.Row, row.Number, line)
+			}
+			continue
+		}
+		taken[key] = row.Line
+	}
+
+	return rows
+}
@@ -0,0 +1,412 @@
+package services
+
+import (
+	"cinema-service/internal/clients"
+	"cinema-service/internal/dto"
+	"cinema-service/internal/models"
+	"cinema-service/internal/repository"
+	"errors"
+	"log/slog"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
+
+type PricingService interface {
+	CreateRule(req dto.PriceRuleRequest) (*models.PriceRule, error)
+	UpdateRule(id uint, req dto.PriceRuleRequest) (*models.PriceRule, error)
+	ListRules() ([]models.PriceRule, error)
+	GetRule(id uint) (*models.PriceRule, error)
+	DeleteRule(id uint) error
+	CreateHoliday(req dto.CreateHolidayRequest) (*models.Holiday, error)
+	ListHolidays() ([]models.Holiday, error)
+	DeleteHoliday(id uint) error
+	PriceSeats(session *models.Session, seats []models.Seat) (map[uint]map[models.TicketCategory]int, error)
+	Preview(sessionID, seatID uint, category models.TicketCategory) (*dto.PricePreviewResponse, error)
+}
+
+type pricingService struct {
+	ruleRepo    repository.PriceRuleRepository
+	holidayRepo repository.HolidayRepository
+	sessionRepo repository.SessionRepository
+	seatRepo    repository.SeatRepository
+	hallRepo    repository.HallRepository
+	cinemaRepo  repository.CinemaRepository
+	logger      *slog.Logger
+}
+
+func NewPricingService(
+	ruleRepo repository.PriceRuleRepository,
+	holidayRepo repository.HolidayRepository,
+	sessionRepo repository.SessionRepository,
+	seatRepo repository.SeatRepository,
+	hallRepo repository.HallRepository,
+	cinemaRepo repository.CinemaRepository,
+	logger *slog.Logger,
+) PricingService {
+	return &pricingService{
+		ruleRepo:    ruleRepo,
+		holidayRepo: holidayRepo,
+		sessionRepo: sessionRepo,
+		seatRepo:    seatRepo,
+		hallRepo:    hallRepo,
+		cinemaRepo:  cinemaRepo,
+		logger:      logger,
+	}
+}
+
+const (
+	holidayDateLayout = "2006-01-02"
+	timeOfDayLayout   = "15:04"
+)
+
+var weekdayNames = map[time.Weekday]string{
+	time.Monday:    "mon",
+	time.Tuesday:   "tue",
+	time.Wednesday: "wed",
+	time.Thursday:  "thu",
+	time.Friday:    "fri",
+	time.Saturday:  "sat",
+	time.Sunday:    "sun",
+}
+
+type pricingContext struct {
+	session   *models.Session
+	start     time.Time
+	occupancy int
+	holiday   bool
+}
+
+func (s *pricingService) CreateRule(req dto.PriceRuleRequest) (*models.PriceRule, error) {
+	rule, err := buildPriceRule(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ruleRepo.Create(rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (s *pricingService) UpdateRule(id uint, req dto.PriceRuleRequest) (*models.PriceRule, error) {
+	existing, err := s.ruleRepo.GetById(id)
+	if err != nil {
+		s.logger.Warn("price rule not found", "id", id, "error", err)
+		return nil, err
+	}
+
+	rule, err := buildPriceRule(req)
+	if err != nil {
+		return nil, err
+	}
+	rule.CreatedAt = existing.CreatedAt
+
+	if err := s.ruleRepo.Update(id, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (s *pricingService) ListRules() ([]models.PriceRule, error) {
+	return s.ruleRepo.List()
+}
+
+func (s *pricingService) GetRule(id uint) (*models.PriceRule, error) {
+	return s.ruleRepo.GetById(id)
+}
+
+func (s *pricingService) DeleteRule(id uint) error {
+	if _, err := s.ruleRepo.GetById(id); err != nil {
+		s.logger.Warn("price rule not found", "id", id, "error", err)
+		return err
+	}
+	return s.ruleRepo.Delete(id)
+}
+
+func (s *pricingService) CreateHoliday(req dto.CreateHolidayRequest) (*models.Holiday, error) {
+	if _, err := time.Parse(holidayDateLayout, req.Date); err != nil {
+		return nil, errors.New("date must be in YYYY-MM-DD format")
+	}
+
+	holiday := &models.Holiday{
+		Date: req.Date,
+		Name: req.Name,
+	}
+	if err := s.holidayRepo.Create(holiday); err != nil {
+		return nil, err
+	}
+
+	return holiday, nil
+}
+
+func (s *pricingService) ListHolidays() ([]models.Holiday, error) {
+	return s.holidayRepo.List()
+}
+
+func (s *pricingService) DeleteHoliday(id uint) error {
+	return s.holidayRepo.Delete(id)
+}
+
+// PriceSeats prices every seat once per ticket category.
+func (s *pricingService) PriceSeats(session *models.Session, seats []models.Seat) (map[uint]map[models.TicketCategory]int, error) {
+	rules, err := s.ruleRepo.ListActive()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err := s.buildContext(session, rules, len(seats))
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make(map[uint]map[models.TicketCategory]int, len(seats))
+	for _, seat := range seats {
+		categoryPrices := make(map[models.TicketCategory]int, len(models.TicketCategories))
+		for _, category := range models.TicketCategories {
+			categoryPrices[category] = evaluateRules(rules, ctx, seat, category).Price
+		}
+		prices[seat.ID] = categoryPrices
+	}
+
+	return prices, nil
+}
+
+func (s *pricingService) Preview(sessionID, seatID uint, category models.TicketCategory) (*dto.PricePreviewResponse, error) {
+	if category == "" {
+		category = models.TicketAdult
+	}
+
+	session, err := s.sessionRepo.GetById(sessionID)
+	if err != nil {
+		s.logger.Warn("session not found", "session_id", sessionID, "error", err)
+		return nil, err
+	}
+
+	seat, err := s.seatRepo.GetById(seatID)
+	if err != nil {
+		s.logger.Warn("seat not found", "seat_id", seatID, "error", err)
+		return nil, err
+	}
+	if seat.HallID != session.HallID {
+		return nil, errors.New("seat does not belong to the session hall")
+	}
+
+	rules, err := s.ruleRepo.ListActive()
+	if err != nil {
+		return nil, err
+	}
+
+	seats, err := s.seatRepo.ListByHallID(session.HallID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err := s.buildContext(session, rules, len(seats))
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PricePreviewResponse{
+		SessionID:      session.ID,
+		SeatID:         seat.ID,
+		SeatType:       seat.Type,
+		Category:       category,
+		Occupancy:      ctx.occupancy,
+		Holiday:        ctx.holiday,
+		PriceBreakdown: evaluateRules(rules, ctx, *seat, category),
+	}, nil
+}
+
+// buildContext resolves the session-wide inputs of the rules. Occupancy is only
+// requested from booking-service when at least one rule depends on it, and a
+// failed request is treated as an empty hall so the seat map stays available.
+func (s *pricingService) buildContext(session *models.Session, rules []models.PriceRule, totalSeats int) (pricingContext, error) {
+	ctx := pricingContext{session: session, start: s.localStart(session)}
+
+	holiday, err := s.holidayRepo.ExistsOn(ctx.start.Format(holidayDateLayout))
+	if err != nil {
+		return ctx, err
+	}
+	ctx.holiday = holiday
+
+	needsOccupancy := slices.ContainsFunc(rules, func(rule models.PriceRule) bool {
+		return rule.MinOccupancy != nil
+	})
+	if !needsOccupancy || totalSeats == 0 {
+		return ctx, nil
+	}
+
+	booked, err := clients.GetBookedSeatIDs(session.ID)
+	if err != nil {
+		s.logger.Warn("failed to fetch session occupancy", "session_id", session.ID, "error", err)
+		return ctx, nil
+	}
+	ctx.occupancy = len(booked) * 100 / totalSeats
+
+	return ctx, nil
+}
+
+// localStart converts the session start to the venue's wall-clock time, which
+// is what weekday, matinee and holiday rules are written against.
+func (s *pricingService) localStart(session *models.Session) time.Time {
+	hall, err := s.hallRepo.GetById(session.HallID)
+	if err != nil {
+		return session.StartTime
+	}
+
+	location, err := hallLocation(s.cinemaRepo, hall)
+	if err != nil {
+		s.logger.Warn("failed to resolve venue time zone", "hall_id", session.HallID, "error", err)
+		return session.StartTime
+	}
+
+	return session.StartTime.In(location)
+}
+
+func evaluateRules(rules []models.PriceRule, ctx pricingContext, seat models.Seat, category models.TicketCategory) dto.PriceBreakdown {
+	surcharge := models.SessionFormatSurcharges[ctx.session.Format]
+	if ctx.session.DolbyAtmos {
+		surcharge += models.DolbyAtmosSurcharge
+	}
+
+	base := models.SeatTypePrices[seat.Type]
+	breakdown := dto.PriceBreakdown{
+		BasePrice:       base,
+		FormatSurcharge: surcharge,
+		Price:           base + surcharge,
+		AppliedRules:    []dto.AppliedPriceRule{},
+	}
+
+	for _, rule := range rules {
+		if !ruleMatches(rule, ctx, seat, category) {
+			continue
+		}
+
+		before := breakdown.Price
+		switch rule.Action {
+		case models.PriceRuleMultiply:
+			breakdown.Price = int(math.Round(float64(before) * rule.Value))
+		case models.PriceRuleAdd:
+			breakdown.Price = before + int(math.Round(rule.Value))
+		case models.PriceRuleFixed:
+			breakdown.Price = int(math.Round(rule.Value))
+		}
+
+		breakdown.AppliedRules = append(breakdown.AppliedRules, dto.AppliedPriceRule{
+			RuleID:      rule.ID,
+			Name:        rule.Name,
+			Action:      rule.Action,
+			Value:       rule.Value,
+			PriceBefore: before,
+			PriceAfter:  breakdown.Price,
+		})
+	}
+
+	// Add rules may be negative discounts; a ticket never costs less than 0.
+	breakdown.Price = max(breakdown.Price, 0)
+
+	return breakdown
+}
+
+func ruleMatches(rule models.PriceRule, ctx pricingContext, seat models.Seat, category models.TicketCategory) bool {
+	if rule.SeatType != nil && *rule.SeatType != seat.Type {
+		return false
+	}
+	if rule.TicketCategory != nil && *rule.TicketCategory != category {
+		return false
+	}
+	if rule.HallID != nil && *rule.HallID != ctx.session.HallID {
+		return false
+	}
+	if rule.MovieID != nil && *rule.MovieID != ctx.session.MovieID {
+		return false
+	}
+	if rule.Format != nil && *rule.Format != ctx.session.Format {
+		return false
+	}
+	if rule.HolidaysOnly && !ctx.holiday {
+		return false
+	}
+	if rule.MinOccupancy != nil && ctx.occupancy < *rule.MinOccupancy {
+		return false
+	}
+	if rule.Weekdays != "" {
+		day := weekdayNames[ctx.start.Weekday()]
+		if !slices.Contains(strings.Split(rule.Weekdays, ","), day) {
+			return false
+		}
+	}
+	if rule.TimeFrom != "" && rule.TimeTo != "" {
+		return inTimeWindow(ctx.start, rule.TimeFrom, rule.TimeTo)
+	}
+	return true
+}
+
+// inTimeWindow reports whether the start time falls in [from, to). Windows
+// where from is later than to wrap around midnight, e.g. 22:00-02:00.
+func inTimeWindow(start time.Time, from, to string) bool {
+	fromTime, err := time.Parse(timeOfDayLayout, from)
+	if err != nil {
+		return false
+	}
+	toTime, err := time.Parse(timeOfDayLayout, to)
+	if err != nil {
+		return false
+	}
+
+	minute := start.Hour()*60 + start.Minute()
+	fromMinute := fromTime.Hour()*60 + fromTime.Minute()
+	toMinute := toTime.Hour()*60 + toTime.Minute()
+
+	if fromMinute <= toMinute {
+		return minute >= fromMinute && minute < toMinute
+	}
+	return minute >= fromMinute || minute < toMinute
+}
+
+func buildPriceRule(req dto.PriceRuleRequest) (*models.PriceRule, error) {
+	if (req.TimeFrom == "") != (req.TimeTo == "") {
+		return nil, errors.New("time_from and time_to must be set together")
+	}
+	for _, value := range []string{req.TimeFrom, req.TimeTo} {
+		if value == "" {
+			continue
+		}
+		if _, err := time.Parse(timeOfDayLayout, value); err != nil {
+			return nil, errors.New("time_from and time_to must be in HH:MM format")
+		}
+	}
+	if req.Action == models.PriceRuleMultiply && req.Value == 0 {
+		return nil, errors.New("multiply rule requires a non-zero value")
+	}
+	if req.Action != models.PriceRuleAdd && req.Value < 0 {
+		return nil, errors.New("only add rules may have a negative value")
+	}
+
+	active := true
+	if req.Active != nil {
+		active = *req.Active
+	}
+
+	return &models.PriceRule{
+		Name:           req.Name,
+		Priority:       req.Priority,
+		Active:         active,
+		SeatType:       req.SeatType,
+		TicketCategory: req.TicketCategory,
+		HallID:         req.HallID,
+		MovieID:        req.MovieID,
+		Format:         req.Format,
+		Weekdays:       strings.Join(req.Weekdays, ","),
+		TimeFrom:       req.TimeFrom,
+		TimeTo:         req.TimeTo,
+		HolidaysOnly:   req.HolidaysOnly,
+		MinOccupancy:   req.MinOccupancy,
+		Action:         req.Action,
+		Value:          req.Value,
+	}, nil
+}
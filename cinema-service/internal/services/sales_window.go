@@ -0,0 +1,52 @@
+package services
+
+import (
+	"cinema-service/internal/models"
+	"errors"
+	"time"
+)
+
+// applySalesDefaults fills the parts of the sales window the session does not
+// set from the venue defaults. Without a venue sales close when the session
+// starts.
+func applySalesDefaults(session *models.Session, cinema *models.Cinema) {
+	if session.SalesCloseAt == nil {
+		closeAt := session.StartTime
+		if cinema != nil {
+			closeAt = closeAt.Add(-time.Duration(cinema.SalesCloseMinutes) * time.Minute)
+		}
+		session.SalesCloseAt = &closeAt
+	}
+	if session.SalesOpenAt == nil && cinema != nil && cinema.SalesOpenDays > 0 {
+		openAt := session.StartTime.AddDate(0, 0, -cinema.SalesOpenDays)
+		session.SalesOpenAt = &openAt
+	}
+}
+
+// shiftSalesWindow moves the sales window together with a rescheduled session.
+func shiftSalesWindow(session *models.Session, offset time.Duration) {
+	for _, at := range []**time.Time{&session.SalesOpenAt, &session.SalesCloseAt, &session.PresaleOpenAt} {
+		if *at != nil {
+			shifted := (*at).Add(offset)
+			*at = &shifted
+		}
+	}
+}
+
+func validateSalesWindow(session *models.Session) error {
+	if session.SalesCloseAt != nil && session.SalesCloseAt.After(session.StartTime) {
+		return errors.New("sales_close_at must not be after start_time")
+	}
+	if session.SalesOpenAt != nil && session.SalesCloseAt != nil && !session.SalesOpenAt.Before(*session.SalesCloseAt) {
+		return errors.New("sales_open_at must be before sales_close_at")
+	}
+	if session.PresaleOpenAt != nil {
+		if session.SalesOpenAt == nil {
+			return errors.New("presale_open_at requires sales_open_at")
+		}
+		if !session.PresaleOpenAt.Before(*session.SalesOpenAt) {
+			return errors.New("presale_open_at must be before sales_open_at")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,427 @@
+package services
+
+import (
+	"cinema-service/internal/config"
+	"cinema-service/internal/constants"
+	"cinema-service/internal/dto"
+	"cinema-service/internal/infrastructure"
+	"cinema-service/internal/models"
+	"cinema-service/internal/repository"
+	"errors"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+type ScheduleTemplateService interface {
+	Create(req dto.ScheduleTemplateRequest) (*models.ScheduleTemplate, error)
+	List() ([]models.ScheduleTemplate, error)
+	GetById(id uint) (*models.ScheduleTemplate, error)
+	Delete(id uint) error
+	Generate(id uint, dryRun bool) (*dto.TemplateRunResult, error)
+	Cancel(id uint) (*dto.TemplateRunResult, error)
+	Shift(id uint, req dto.TemplateShiftRequest) (*dto.TemplateRunResult, error)
+}
+
+type scheduleTemplateService struct {
+	templateRepo repository.ScheduleTemplateRepository
+	sessionRepo  repository.SessionRepository
+	hallRepo     repository.HallRepository
+	cinemaRepo   repository.CinemaRepository
+	events       infrastructure.SessionEventPublisher
+	logger       *slog.Logger
+}
+
+func NewScheduleTemplateService(
+	templateRepo repository.ScheduleTemplateRepository,
+	sessionRepo repository.SessionRepository,
+	hallRepo repository.HallRepository,
+	cinemaRepo repository.CinemaRepository,
+	events infrastructure.SessionEventPublisher,
+	logger *slog.Logger,
+) ScheduleTemplateService {
+	return &scheduleTemplateService{
+		templateRepo: templateRepo,
+		sessionRepo:  sessionRepo,
+		hallRepo:     hallRepo,
+		cinemaRepo:   cinemaRepo,
+		events:       events,
+		logger:       logger,
+	}
+}
+
+func (s *scheduleTemplateService) Create(req dto.ScheduleTemplateRequest) (*models.ScheduleTemplate, error) {
+
+	from, err := time.ParseInLocation(constants.FreeSlotDateLayout, req.DateFrom, time.Local)
+	if err != nil {
+		return nil, errors.New("date_from must be in YYYY-MM-DD format")
+	}
+	to, err := time.ParseInLocation(constants.FreeSlotDateLayout, req.DateTo, time.Local)
+	if err != nil {
+		return nil, errors.New("date_to must be in YYYY-MM-DD format")
+	}
+	if to.Before(from) {
+		return nil, errors.New("date_to must not be before date_from")
+	}
+	if to.Sub(from) > constants.MaxTemplateDays*24*time.Hour {
+		return nil, errors.New("template date range is too long")
+	}
+
+	startTimes := make([]string, 0, len(req.StartTimes))
+	for _, value := range req.StartTimes {
+		if _, err := time.Parse(timeOfDayLayout, value); err != nil {
+			return nil, errors.New("start_times must be in HH:MM format")
+		}
+		if !slices.Contains(startTimes, value) {
+			startTimes = append(startTimes, value)
+		}
+	}
+	sort.Strings(startTimes)
+
+	hall, err := s.hallRepo.GetById(req.HallID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Format == "" {
+		req.Format = models.SessionFormat2D
+	}
+	if err := checkHallCapabilities(hall, req.Format, req.DolbyAtmos); err != nil {
+		return nil, err
+	}
+	if _, err := activeMovie(req.MovieID, s.logger); err != nil {
+		return nil, err
+	}
+
+	template := &models.ScheduleTemplate{
+		Name:       req.Name,
+		MovieID:    req.MovieID,
+		HallID:     req.HallID,
+		Weekdays:   strings.Join(req.Weekdays, ","),
+		StartTimes: strings.Join(startTimes, ","),
+		DateFrom:   req.DateFrom,
+		DateTo:     req.DateTo,
+
+		Format:           req.Format,
+		DolbyAtmos:       req.DolbyAtmos,
+		AudioLanguage:    req.AudioLanguage,
+		SubtitleLanguage: req.SubtitleLanguage,
+	}
+
+	if err := s.templateRepo.Create(template); err != nil {
+		s.logger.Error(
+			"failed to create schedule template",
+			"movie_id", req.MovieID,
+			"hall_id", req.HallID,
+			"err", err,
+		)
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (s *scheduleTemplateService) List() ([]models.ScheduleTemplate, error) {
+	return s.templateRepo.List()
+}
+
+func (s *scheduleTemplateService) GetById(id uint) (*models.ScheduleTemplate, error) {
+	return s.templateRepo.GetById(id)
+}
+
+// Delete removes the template only. Sessions it generated stay on the schedule
+// and are no longer linked to it.
+func (s *scheduleTemplateService) Delete(id uint) error {
+	if _, err := s.templateRepo.GetById(id); err != nil {
+		return err
+	}
+	return s.templateRepo.Delete(id)
+}
+
+// Generate creates a session for every occurrence of the template. Occurrences
+// that already have a session, lie in the past or clash with another session
+// are skipped and reported instead of failing the whole run.
+func (s *scheduleTemplateService) Generate(id uint, dryRun bool) (*dto.TemplateRunResult, error) {
+
+	template, err := s.templateRepo.GetById(id)
+	if err != nil {
+		return nil, err
+	}
+
+	movie, err := activeMovie(template.MovieID, s.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	hall, err := s.hallRepo.GetById(template.HallID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkHallCapabilities(hall, template.Format, template.DolbyAtmos); err != nil {
+		return nil, err
+	}
+	cinema, err := hallCinema(s.cinemaRepo, hall)
+	if err != nil {
+		return nil, err
+	}
+	location := time.Local
+	if cinema != nil {
+		location = cinemaLocation(cinema)
+	}
+
+	now := time.Now()
+	existing, err := s.sessionRepo.ListUpcomingByTemplateID(template.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	existingByStart := make(map[int64]uint, len(existing))
+	for _, session := range existing {
+		existingByStart[session.StartTime.Unix()] = session.ID
+	}
+
+	result := &dto.TemplateRunResult{TemplateID: template.ID, DryRun: dryRun, Occurrences: []dto.TemplateOccurrence{}}
+	planned := make([]models.Session, 0)
+
+	for _, start := range templateOccurrences(template, location) {
+		occurrence := dto.TemplateOccurrence{StartTime: start}
+
+		switch sessionID, exists := existingByStart[start.Unix()]; {
+		case !start.After(now):
+			occurrence.Result = constants.OccurrencePast
+		case exists:
+			occurrence.Result = constants.OccurrenceExists
+			occurrence.SessionID = sessionID
+		}
+		if occurrence.Result != "" {
+			result.Skipped++
+			result.Occurrences = append(result.Occurrences, occurrence)
+			continue
+		}
+
+		session := models.Session{
+			MovieID:    template.MovieID,
+			HallID:     template.HallID,
+			StartTime:  start,
+			EndTime:    sessionEndTime(start, movie),
+			Status:     models.SessionStatusScheduled,
+			TemplateID: &template.ID,
+
+			Format:           template.Format,
+			DolbyAtmos:       template.DolbyAtmos,
+			AudioLanguage:    template.AudioLanguage,
+			SubtitleLanguage: template.SubtitleLanguage,
+		}
+		applySalesDefaults(&session, cinema)
+
+		conflicts, err := overlappingSessionIDs(s.sessionRepo, session.HallID, session.StartTime, session.EndTime, 0)
+		if err != nil {
+			return nil, err
+		}
+		if len(conflicts) == 0 && dryRun && overlapsPlanned(planned, session) {
+			occurrence.Error = "overlaps another occurrence of this template"
+		}
+		if len(conflicts) > 0 || occurrence.Error != "" {
+			occurrence.Result = constants.OccurrenceConflict
+			occurrence.ConflictingIDs = conflicts
+			result.Skipped++
+			result.Occurrences = append(result.Occurrences, occurrence)
+			continue
+		}
+
+		if dryRun {
+			planned = append(planned, session)
+			occurrence.Result = constants.OccurrencePlanned
+		} else {
+			from, to := overlapWindow(session.StartTime, session.EndTime)
+			conflicts, err := s.sessionRepo.CreateInFreeSlot(&session, from, to)
+			switch {
+			case err != nil:
+				occurrence.Result = constants.OccurrenceFailed
+				occurrence.Error = err.Error()
+			case len(conflicts) > 0:
+				occurrence.Result = constants.OccurrenceConflict
+				occurrence.ConflictingIDs = conflicts
+			}
+			if occurrence.Result != "" {
+				result.Skipped++
+				result.Occurrences = append(result.Occurrences, occurrence)
+				continue
+			}
+			occurrence.Result = constants.OccurrenceCreated
+			occurrence.SessionID = session.ID
+		}
+
+		result.Applied++
+		result.Occurrences = append(result.Occurrences, occurrence)
+	}
+
+	s.logger.Info(
+		"schedule template generated",
+		"template_id", template.ID,
+		"dry_run", dryRun,
+		"applied", result.Applied,
+		"skipped", result.Skipped,
+	)
+
+	return result, nil
+}
+
+// Cancel cancels every upcoming scheduled session produced by the template.
+func (s *scheduleTemplateService) Cancel(id uint) (*dto.TemplateRunResult, error) {
+
+	sessions, err := s.upcomingSessions(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.TemplateRunResult{TemplateID: id, Occurrences: []dto.TemplateOccurrence{}}
+	for _, session := range sessions {
+		occurrence := dto.TemplateOccurrence{StartTime: session.StartTime, SessionID: session.ID}
+
+		updated, err := s.sessionRepo.UpdateStatus(session.ID, models.SessionStatusScheduled, models.SessionStatusCancelled)
+		if err != nil {
+			return nil, err
+		}
+		if !updated {
+			continue
+		}
+
+		session.Status = models.SessionStatusCancelled
+		publishStatusChanged(s.events, s.logger, session, models.SessionStatusScheduled)
+
+		occurrence.Result = constants.OccurrenceCanceled
+		result.Applied++
+		result.Occurrences = append(result.Occurrences, occurrence)
+	}
+
+	return result, nil
+}
+
+// Shift moves every upcoming scheduled session of the template by the given
+// number of minutes. Sessions are moved in the direction of the shift so the
+// template's own sessions never block each other. Sessions that already have
+// bookings are left alone, since their tickets were sold for the old time.
+func (s *scheduleTemplateService) Shift(id uint, req dto.TemplateShiftRequest) (*dto.TemplateRunResult, error) {
+
+	sessions, err := s.upcomingSessions(id)
+	if err != nil {
+		return nil, err
+	}
+	if req.Minutes > 0 {
+		slices.Reverse(sessions)
+	}
+
+	now := time.Now()
+	offset := time.Duration(req.Minutes) * time.Minute
+	result := &dto.TemplateRunResult{TemplateID: id, Occurrences: []dto.TemplateOccurrence{}}
+
+	for _, session := range sessions {
+		session.StartTime = session.StartTime.Add(offset)
+		session.EndTime = session.EndTime.Add(offset)
+		shiftSalesWindow(&session, offset)
+		occurrence := dto.TemplateOccurrence{StartTime: session.StartTime, SessionID: session.ID}
+
+		if !session.StartTime.After(now) {
+			occurrence.Result = constants.OccurrencePast
+			result.Skipped++
+			result.Occurrences = append(result.Occurrences, occurrence)
+			continue
+		}
+
+		err := checkActiveBookings([]uint{session.ID}, nil)
+		if errors.Is(err, constants.ErrActiveBookings) {
+			occurrence.Result = constants.OccurrenceBooked
+			occurrence.Error = err.Error()
+			result.Skipped++
+			result.Occurrences = append(result.Occurrences, occurrence)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		from, to := overlapWindow(session.StartTime, session.EndTime)
+		conflicts, err := s.sessionRepo.UpdateInFreeSlot(session.ID, &session, from, to)
+		if err != nil {
+			return nil, err
+		}
+		if len(conflicts) > 0 {
+			occurrence.Result = constants.OccurrenceConflict
+			occurrence.ConflictingIDs = conflicts
+			result.Skipped++
+			result.Occurrences = append(result.Occurrences, occurrence)
+			continue
+		}
+
+		occurrence.Result = constants.OccurrenceShifted
+		result.Applied++
+		result.Occurrences = append(result.Occurrences, occurrence)
+	}
+
+	return result, nil
+}
+
+func (s *scheduleTemplateService) upcomingSessions(id uint) ([]models.Session, error) {
+	if _, err := s.templateRepo.GetById(id); err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.sessionRepo.ListUpcomingByTemplateID(id, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	scheduled := make([]models.Session, 0, len(sessions))
+	for _, session := range sessions {
+		if session.Status == models.SessionStatusScheduled {
+			scheduled = append(scheduled, session)
+		}
+	}
+	return scheduled, nil
+}
+
+// templateOccurrences expands the template into start times, in order. Dates
+// and start times are wall-clock times of the hall's venue.
+func templateOccurrences(template *models.ScheduleTemplate, location *time.Location) []time.Time {
+	from, err := time.ParseInLocation(constants.FreeSlotDateLayout, template.DateFrom, location)
+	if err != nil {
+		return nil
+	}
+	to, err := time.ParseInLocation(constants.FreeSlotDateLayout, template.DateTo, location)
+	if err != nil {
+		return nil
+	}
+
+	weekdays := strings.Split(template.Weekdays, ",")
+	startTimes := strings.Split(template.StartTimes, ",")
+
+	occurrences := make([]time.Time, 0)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		if !slices.Contains(weekdays, weekdayNames[day.Weekday()]) {
+			continue
+		}
+		for _, value := range startTimes {
+			clock, err := time.Parse(timeOfDayLayout, value)
+			if err != nil {
+				continue
+			}
+			occurrences = append(occurrences, time.Date(
+				day.Year(), day.Month(), day.Day(),
+				clock.Hour(), clock.Minute(), 0, 0, location,
+			))
+		}
+	}
+
+	return occurrences
+}
+
+func overlapsPlanned(planned []models.Session, session models.Session) bool {
+	buffer := config.CleaningBuffer()
+	for _, other := range planned {
+		if session.StartTime.Before(other.EndTime.Add(buffer)) && session.EndTime.Add(buffer).After(other.StartTime) {
+			return true
+		}
+	}
+	return false
+}
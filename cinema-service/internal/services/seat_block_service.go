@@ -0,0 +1,135 @@
+package services
+
+import (
+	"cinema-service/internal/clients"
+	"cinema-service/internal/constants"
+	"cinema-service/internal/dto"
+	"cinema-service/internal/models"
+	"cinema-service/internal/repository"
+	"fmt"
+	"log/slog"
+)
+
+type SeatBlockService interface {
+	List(sessionID uint) ([]models.SeatBlock, error)
+	Block(sessionID uint, req dto.SeatBlockRequest) ([]models.SeatBlock, error)
+	Unblock(sessionID, seatID uint) error
+}
+
+type seatBlockService struct {
+	blockRepo   repository.SeatBlockRepository
+	sessionRepo repository.SessionRepository
+	seatRepo    repository.SeatRepository
+	logger      *slog.Logger
+}
+
+func NewSeatBlockService(
+	blockRepo repository.SeatBlockRepository,
+	sessionRepo repository.SessionRepository,
+	seatRepo repository.SeatRepository,
+	logger *slog.Logger,
+) SeatBlockService {
+	return &seatBlockService{
+		blockRepo:   blockRepo,
+		sessionRepo: sessionRepo,
+		seatRepo:    seatRepo,
+		logger:      logger,
+	}
+}
+
+func (s *seatBlockService) List(sessionID uint) ([]models.SeatBlock, error) {
+	if _, err := s.sessionRepo.GetById(sessionID); err != nil {
+		s.logger.Warn("session not found", "session_id", sessionID, "error", err)
+		return nil, err
+	}
+
+	return s.blockRepo.ListBySessionID(sessionID)
+}
+
+// Block withholds the seats from sale for the session. Seats that already have
+// a booking cannot be blocked; they have to be cancelled in booking-service
+// first.
+func (s *seatBlockService) Block(sessionID uint, req dto.SeatBlockRequest) ([]models.SeatBlock, error) {
+	session, err := s.openSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	seats, err := s.seatRepo.ListByHallID(session.HallID)
+	if err != nil {
+		s.logger.Error("failed to list hall seats", "hall_id", session.HallID, "err", err)
+		return nil, err
+	}
+	inHall := make(map[uint]bool, len(seats))
+	for _, seat := range seats {
+		inHall[seat.ID] = true
+	}
+
+	bookedIDs, err := clients.GetBookedSeatIDs(sessionID)
+	if err != nil {
+		s.logger.Error("failed to fetch booked seats", "session_id", sessionID, "err", err)
+		return nil, err
+	}
+	booked := make(map[uint]bool, len(bookedIDs))
+	for _, id := range bookedIDs {
+		booked[id] = true
+	}
+
+	blocks := make([]models.SeatBlock, 0, len(req.SeatIDs))
+	seen := make(map[uint]bool, len(req.SeatIDs))
+	for _, seatID := range req.SeatIDs {
+		if seen[seatID] {
+			continue
+		}
+		seen[seatID] = true
+
+		if !inHall[seatID] {
+			return nil, fmt.Errorf("%w: %d", constants.ErrSeatNotInHall, seatID)
+		}
+		if booked[seatID] {
+			return nil, fmt.Errorf("%w: %d", constants.ErrSeatBooked, seatID)
+		}
+		blocks = append(blocks, models.SeatBlock{
+			SessionID: sessionID,
+			SeatID:    seatID,
+			Reason:    req.Reason,
+			Note:      req.Note,
+		})
+	}
+
+	if err := s.blockRepo.CreateBatch(blocks); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("seats blocked", "session_id", sessionID, "count", len(blocks), "reason", req.Reason)
+	return blocks, nil
+}
+
+func (s *seatBlockService) Unblock(sessionID, seatID uint) error {
+	if _, err := s.openSession(sessionID); err != nil {
+		return err
+	}
+
+	deleted, err := s.blockRepo.Delete(sessionID, seatID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return constants.ErrSeatBlockNotFound
+	}
+
+	s.logger.Info("seat unblocked", "session_id", sessionID, "seat_id", seatID)
+	return nil
+}
+
+func (s *seatBlockService) openSession(sessionID uint) (*models.Session, error) {
+	session, err := s.sessionRepo.GetById(sessionID)
+	if err != nil {
+		s.logger.Warn("session not found", "session_id", sessionID, "error", err)
+		return nil, err
+	}
+	if session.Status == models.SessionStatusFinished || session.Status == models.SessionStatusCancelled {
+		return nil, constants.ErrSessionClosed
+	}
+	return session, nil
+}
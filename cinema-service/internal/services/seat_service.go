@@ -1,34 +1,57 @@
 package services
 
 import (
+	"cinema-service/internal/constants"
 	"cinema-service/internal/dto"
+	"cinema-service/internal/infrastructure"
 	"cinema-service/internal/models"
 	"cinema-service/internal/repository"
+	"errors"
+	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
 )
 
 type SeatService interface {
 	Create(hallID uint, req dto.CreateSeatRequest) (*models.Seat, error)
 	UpdateSeat(id uint, req dto.UpdateSeatRequest) (*models.Seat, error)
 	List() ([]models.Seat, error)
-	Delete(id uint) error
+	Delete(id uint, force bool) error
+	GenerateLayout(hallID uint, req dto.HallLayoutRequest) ([]dto.SeatRowResponse, error)
+	ListByRow(hallID uint) ([]dto.SeatRowResponse, error)
+	ExportLayout(hallID uint) ([]models.Seat, error)
+	ImportLayout(hallID uint, rows []dto.SeatImportRow, mode string, dryRun bool) (*dto.LayoutImportResult, error)
+
+	CreateGroup(hallID uint, req dto.CreateSeatGroupRequest) (*models.SeatGroup, error)
+	ListGroups(hallID uint) ([]models.SeatGroup, error)
+	DeleteGroup(id uint) error
 }
 
 type seatService struct {
-	seatRepo repository.SeatRepository
-	hallRepo repository.HallRepository
-	logger   *slog.Logger
+	seatRepo    repository.SeatRepository
+	groupRepo   repository.SeatGroupRepository
+	hallRepo    repository.HallRepository
+	sessionRepo repository.SessionRepository
+	events      infrastructure.SessionEventPublisher
+	logger      *slog.Logger
 }
 
 func NewSeatService(
 	seatRepo repository.SeatRepository,
+	groupRepo repository.SeatGroupRepository,
 	hallRepo repository.HallRepository,
+	sessionRepo repository.SessionRepository,
+	events infrastructure.SessionEventPublisher,
 	logger *slog.Logger,
 ) SeatService {
 	return &seatService{
-		seatRepo: seatRepo,
-		hallRepo: hallRepo,
-		logger:   logger,
+		seatRepo:    seatRepo,
+		groupRepo:   groupRepo,
+		hallRepo:    hallRepo,
+		sessionRepo: sessionRepo,
+		events:      events,
+		logger:      logger,
 	}
 }
 
@@ -44,10 +67,13 @@ func (s *seatService) Create(hallID uint, req dto.CreateSeatRequest) (*models.Se
 	}
 
 	seat := &models.Seat{
-		HallID: hallID,
-		Row:    req.Row,
-		Number: req.Number,
-		Type:   req.Type,
+		HallID:  hallID,
+		Row:     req.Row,
+		Number:  req.Number,
+		Type:    req.Type,
+		X:       req.X,
+		Y:       req.Y,
+		Section: req.Section,
 	}
 	if err := s.seatRepo.Create(seat); err != nil {
 		s.logger.Error(
@@ -74,6 +100,18 @@ func (s *seatService) UpdateSeat(id uint, req dto.UpdateSeatRequest) (*models.Se
 	if req.Type != nil {
 		seat.Type = *req.Type
 	}
+	if req.X != nil {
+		seat.X = req.X
+	}
+	if req.Y != nil {
+		seat.Y = req.Y
+	}
+	if req.ClearPosition {
+		seat.X, seat.Y = nil, nil
+	}
+	if req.Section != nil {
+		seat.Section = *req.Section
+	}
 
 	if err := s.seatRepo.Update(id, seat); err != nil {
 		s.logger.Error(
@@ -102,12 +140,353 @@ func (s *seatService) List() ([]models.Seat, error) {
 	return seats, nil
 }
 
-func (s seatService) Delete(id uint) error {
-	err := s.seatRepo.Delete(id)
-	if err != nil {
+func (s seatService) Delete(id uint, force bool) error {
+	if _, err := s.seatRepo.GetById(id); err != nil {
+		return err
+	}
+
+	if err := clearActiveBookings(s.events, constants.RevokeReasonSeatDeleted, nil, []uint{id}, force); err != nil {
+		return err
+	}
+
+	if err := s.seatRepo.Delete(id); err != nil {
 		s.logger.Error("failed to delete seat", "id", id)
 		return err
 	}
 	s.logger.Info("seat deleted successfully", "id", id)
 	return nil
 }
+
+// GenerateLayout builds the whole seat grid of a hall. Gap positions are left
+// empty, so seat numbers keep their position in the row and aisles show up as
+// holes in the numbering.
+func (s *seatService) GenerateLayout(hallID uint, req dto.HallLayoutRequest) ([]dto.SeatRowResponse, error) {
+
+	if _, err := s.hallRepo.GetById(hallID); err != nil {
+		s.logger.Warn(
+			"hall not found while generating layout",
+			"hall_id", hallID,
+			"error", err,
+		)
+		return nil, err
+	}
+
+	existing, err := s.seatRepo.ListByHallID(hallID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		if !req.Replace {
+			return nil, errors.New("hall already has seats, set replace to true to overwrite the layout")
+		}
+		if err := s.ensureReplaceable(hallID); err != nil {
+			return nil, err
+		}
+	}
+
+	seats, err := buildLayout(hallID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.seatRepo.ReplaceHallSeats(hallID, seats, checkReplaceable); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("hall layout generated", "hall_id", hallID, "seats", len(seats))
+
+	return groupSeatsByRow(seats), nil
+}
+
+func (s *seatService) ListByRow(hallID uint) ([]dto.SeatRowResponse, error) {
+
+	if _, err := s.hallRepo.GetById(hallID); err != nil {
+		return nil, err
+	}
+
+	seats, err := s.seatRepo.ListByHallID(hallID)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupSeatsByRow(seats), nil
+}
+
+func buildLayout(hallID uint, req dto.HallLayoutRequest) ([]models.Seat, error) {
+	defaultType := req.DefaultType
+	if defaultType == "" {
+		defaultType = models.SeatTypeStandard
+	}
+
+	rowSpecs := make(map[int]dto.RowLayoutSpec, len(req.RowSpecs))
+	for _, spec := range req.RowSpecs {
+		if spec.Row > req.Rows {
+			return nil, fmt.Errorf("row spec for row %d is outside of %d rows", spec.Row, req.Rows)
+		}
+		if _, ok := rowSpecs[spec.Row]; ok {
+			return nil, fmt.Errorf("duplicate row spec for row %d", spec.Row)
+		}
+		rowSpecs[spec.Row] = spec
+	}
+
+	seats := make([]models.Seat, 0, req.Rows*req.SeatsPerRow)
+	for row := 1; row <= req.Rows; row++ {
+		seatsPerRow := req.SeatsPerRow
+		gaps := req.Gaps
+		seatType := defaultType
+		section := ""
+
+		if spec, ok := rowSpecs[row]; ok {
+			if spec.SeatsPerRow != nil {
+				seatsPerRow = *spec.SeatsPerRow
+			}
+			if spec.Gaps != nil {
+				gaps = spec.Gaps
+			}
+			if spec.Type != "" {
+				seatType = spec.Type
+			}
+			section = spec.Section
+		}
+
+		for number := 1; number <= seatsPerRow; number++ {
+			if slices.Contains(gaps, number) {
+				continue
+			}
+			seats = append(seats, models.Seat{
+				HallID:  hallID,
+				Row:     row,
+				Number:  number,
+				Type:    seatType,
+				Section: section,
+			})
+		}
+	}
+
+	if len(seats) == 0 {
+		return nil, errors.New("layout has no seats")
+	}
+
+	return seats, nil
+}
+
+func groupSeatsByRow(seats []models.Seat) []dto.SeatRowResponse {
+	rows := make([]dto.SeatRowResponse, 0)
+
+	for _, seat := range seats {
+		if len(rows) == 0 || rows[len(rows)-1].Row != seat.Row {
+			rows = append(rows, dto.SeatRowResponse{Row: seat.Row, Seats: []models.Seat{}})
+		}
+		last := &rows[len(rows)-1]
+		last.Seats = append(last.Seats, seat)
+	}
+
+	return rows
+}
+
+func (s *seatService) ExportLayout(hallID uint) ([]models.Seat, error) {
+
+	if _, err := s.hallRepo.GetById(hallID); err != nil {
+		return nil, err
+	}
+
+	return s.seatRepo.ListByHallID(hallID)
+}
+
+// ImportLayout applies the imported seats only when every row is valid, so a
+// file is either imported completely or not at all.
+func (s *seatService) ImportLayout(hallID uint, rows []dto.SeatImportRow, mode string, dryRun bool) (*dto.LayoutImportResult, error) {
+
+	if _, err := s.hallRepo.GetById(hallID); err != nil {
+		return nil, err
+	}
+
+	if mode == "" {
+		mode = LayoutModeAppend
+	}
+	// Checked before validating, so a dry run of a replace that would be
+	// refused reports the refusal.
+	if mode == LayoutModeReplace {
+		if err := s.ensureReplaceable(hallID); err != nil {
+			return nil, err
+		}
+	}
+
+	var existing []models.Seat
+	if mode == LayoutModeAppend {
+		seats, err := s.seatRepo.ListByHallID(hallID)
+		if err != nil {
+			return nil, err
+		}
+		existing = seats
+	}
+
+	rows = validateImportRows(rows, existing)
+
+	result := &dto.LayoutImportResult{
+		DryRun: dryRun,
+		Mode:   mode,
+		Total:  len(rows),
+		Errors: []dto.SeatImportRow{},
+	}
+
+	seats := make([]models.Seat, 0, len(rows))
+	for _, row := range rows {
+		if row.Error != "" {
+			result.Errors = append(result.Errors, row)
+			continue
+		}
+		seats = append(seats, models.Seat{
+			HallID:  hallID,
+			Row:     row.Row,
+			Number:  row.Number,
+			Type:    row.Type,
+			X:       row.X,
+			Y:       row.Y,
+			Section: row.Section,
+		})
+	}
+	result.Valid = len(seats)
+
+	if dryRun || len(result.Errors) > 0 {
+		return result, nil
+	}
+
+	if len(seats) == 0 {
+		return nil, errors.New("layout has no seats")
+	}
+
+	if mode == LayoutModeReplace {
+		if err := s.seatRepo.ReplaceHallSeats(hallID, seats, checkReplaceable); err != nil {
+			return nil, err
+		}
+	} else if err := s.seatRepo.CreateBatch(seats); err != nil {
+		return nil, err
+	}
+
+	result.Created = len(seats)
+	s.logger.Info("hall layout imported", "hall_id", hallID, "mode", mode, "seats", len(seats))
+
+	return result, nil
+}
+
+// ensureReplaceable checks up front whether the layout of the hall could be
+// replaced. ReplaceHallSeats runs the same check again under the hall lock.
+func (s *seatService) ensureReplaceable(hallID uint) error {
+	unended, err := s.sessionRepo.ListUnendedByHallID(hallID)
+	if err != nil {
+		return err
+	}
+	return checkReplaceable(unended)
+}
+
+// checkReplaceable refuses to replace the seats of a hall while sessions are
+// running or coming up, or bookings for sessions that have not ended still
+// point at its seats, since replacing the layout removes the old seats for good.
+func checkReplaceable(unended []models.Session) error {
+	sessionIDs := make([]uint, 0, len(unended))
+	var unfinished int
+	for _, session := range unended {
+		sessionIDs = append(sessionIDs, session.ID)
+		if session.Status == models.SessionStatusScheduled || session.Status == models.SessionStatusOngoing {
+			unfinished++
+		}
+	}
+	if unfinished > 0 {
+		return fmt.Errorf("hall has %d upcoming or ongoing sessions, layout cannot be replaced", unfinished)
+	}
+
+	return checkActiveBookings(sessionIDs, nil)
+}
+
+// CreateGroup joins seats of the hall into a group. A seat can only be in one
+// group; the group has to be deleted before its seats are regrouped.
+func (s *seatService) CreateGroup(hallID uint, req dto.CreateSeatGroupRequest) (*models.SeatGroup, error) {
+
+	if _, err := s.hallRepo.GetById(hallID); err != nil {
+		return nil, err
+	}
+
+	groups, err := s.groupRepo.ListByHallID(hallID)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		if strings.EqualFold(group.Name, req.Name) {
+			return nil, constants.ErrSeatGroupNameTaken
+		}
+	}
+
+	seats, err := s.seatRepo.ListByHallID(hallID)
+	if err != nil {
+		return nil, err
+	}
+	hallSeats := make(map[uint]models.Seat, len(seats))
+	for _, seat := range seats {
+		hallSeats[seat.ID] = seat
+	}
+
+	seatIDs := make([]uint, 0, len(req.SeatIDs))
+	members := make([]models.Seat, 0, len(req.SeatIDs))
+	for _, id := range req.SeatIDs {
+		if slices.Contains(seatIDs, id) {
+			continue
+		}
+		seat, ok := hallSeats[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: %d", constants.ErrSeatNotInHall, id)
+		}
+		if seat.GroupID != nil {
+			return nil, fmt.Errorf("%w: %d", constants.ErrSeatAlreadyGrouped, id)
+		}
+		seatIDs = append(seatIDs, id)
+		members = append(members, seat)
+	}
+	if len(seatIDs) < 2 {
+		return nil, errors.New("seat group needs at least two seats")
+	}
+
+	group := &models.SeatGroup{
+		HallID:       hallID,
+		Name:         req.Name,
+		SellTogether: true,
+	}
+	if req.SellTogether != nil {
+		group.SellTogether = *req.SellTogether
+	}
+
+	if err := s.groupRepo.Create(group, seatIDs); err != nil {
+		return nil, err
+	}
+
+	for i := range members {
+		members[i].GroupID = &group.ID
+	}
+	group.Seats = members
+
+	s.logger.Info("seat group created", "hall_id", hallID, "group_id", group.ID, "seats", len(seatIDs))
+	return group, nil
+}
+
+func (s *seatService) ListGroups(hallID uint) ([]models.SeatGroup, error) {
+
+	if _, err := s.hallRepo.GetById(hallID); err != nil {
+		return nil, err
+	}
+
+	return s.groupRepo.ListByHallID(hallID)
+}
+
+func (s *seatService) DeleteGroup(id uint) error {
+
+	if _, err := s.groupRepo.GetById(id); err != nil {
+		return err
+	}
+
+	if err := s.groupRepo.Delete(id); err != nil {
+		return err
+	}
+
+	s.logger.Info("seat group deleted", "group_id", id)
+	return nil
+}
@@ -0,0 +1,235 @@
+package services
+
+import (
+	"cinema-service/internal/clients"
+	"cinema-service/internal/constants"
+	"cinema-service/internal/dto"
+	"cinema-service/internal/ics"
+	"cinema-service/internal/models"
+	"cinema-service/internal/repository"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Calendar returns the sessions of a movie, hall or venue as calendar events,
+// from a week ago up to CalendarDays ahead. Cancelled sessions stay in the
+// feed marked as cancelled, so subscribed calendars drop them.
+func (s *sessionService) Calendar(query dto.CalendarQuery) (string, []ics.Event, error) {
+
+	name, err := s.calendarName(query)
+	if err != nil {
+		return "", nil, err
+	}
+
+	now := time.Now()
+	from := now.AddDate(0, 0, -constants.CalendarPastDays)
+	to := now.AddDate(0, 0, constants.CalendarDays)
+	sessions, _, err := s.sessionRepo.Search(repository.SessionFilter{
+		CinemaID: query.CinemaID,
+		MovieID:  query.MovieID,
+		HallID:   query.HallID,
+		From:     &from,
+		To:       &to,
+		OrderBy:  "start_time",
+	})
+	if err != nil {
+		s.logger.Error("failed to list sessions for calendar", "err", err)
+		return "", nil, err
+	}
+
+	hallIDs := make([]uint, 0, len(sessions))
+	movieIDs := make([]uint, 0)
+	seenMovies := make(map[uint]bool)
+	for _, session := range sessions {
+		hallIDs = append(hallIDs, session.HallID)
+		if !seenMovies[session.MovieID] {
+			seenMovies[session.MovieID] = true
+			movieIDs = append(movieIDs, session.MovieID)
+		}
+	}
+	halls, err := s.hallRepo.ListByIDs(hallIDs)
+	if err != nil {
+		return "", nil, err
+	}
+
+	locations, err := s.hallLocations(halls)
+	if err != nil {
+		return "", nil, err
+	}
+
+	movies, err := clients.GetMovies(movieIDs)
+	if err != nil {
+		s.logger.Warn("failed to fetch movies for calendar", "movie_ids", movieIDs, "err", err)
+	}
+
+	events := make([]ics.Event, 0, len(sessions))
+	for _, session := range sessions {
+		title := fmt.Sprintf("Movie #%d", session.MovieID)
+		if movie, ok := movies[session.MovieID]; ok {
+			title = movie.Title
+		}
+
+		events = append(events, ics.Event{
+			UID:         fmt.Sprintf("session-%d@cinema-service", session.ID),
+			Summary:     title,
+			Description: sessionDescription(session),
+			Location:    locations[session.HallID],
+			Start:       session.StartTime,
+			End:         session.EndTime,
+			Cancelled:   session.Status == models.SessionStatusCancelled,
+		})
+	}
+
+	return name, events, nil
+}
+
+// BookingCalendar describes a booking of the session as a calendar event
+// with the movie title, the venue and hall, and the booked seats. Cancelled
+// and expired bookings are kept as cancelled events so calendars remove them.
+func (s *sessionService) BookingCalendar(sessionID uint, query dto.BookingCalendarQuery) (ics.Event, error) {
+	seatIDs, err := parseIDList(query.SeatIDs)
+	if err != nil {
+		return ics.Event{}, err
+	}
+
+	session, err := s.sessionRepo.GetById(sessionID)
+	if err != nil {
+		return ics.Event{}, err
+	}
+
+	title := fmt.Sprintf("Session #%d", session.ID)
+	if movie, err := clients.GetMovie(session.MovieID); err != nil {
+		s.logger.Warn("failed to fetch movie for calendar", "movie_id", session.MovieID, "err", err)
+	} else {
+		title = movie.Title
+	}
+
+	hall, err := s.hallRepo.GetById(session.HallID)
+	if err != nil {
+		return ics.Event{}, err
+	}
+	locations, err := s.hallLocations([]models.Hall{*hall})
+	if err != nil {
+		return ics.Event{}, err
+	}
+
+	description := []string{fmt.Sprintf("Booking #%d", query.BookingID)}
+	if len(seatIDs) > 0 {
+		seats, err := s.seatRepo.ListByHallID(session.HallID)
+		if err != nil {
+			return ics.Event{}, err
+		}
+		description = append(description, "Seats: "+strings.Join(seatLabels(seats, seatIDs), ", "))
+	}
+
+	return ics.Event{
+		UID:         fmt.Sprintf("booking-%d@booking-service", query.BookingID),
+		Summary:     title,
+		Description: strings.Join(description, "\n"),
+		Location:    locations[hall.ID],
+		Start:       session.StartTime,
+		End:         session.EndTime,
+		Cancelled:   query.Cancelled,
+	}, nil
+}
+
+// hallLocations describes where each hall is: venue name, hall number and
+// venue address.
+func (s *sessionService) hallLocations(halls []models.Hall) (map[uint]string, error) {
+	locations := make(map[uint]string, len(halls))
+	cinemas := make(map[uint]*models.Cinema)
+	for _, hall := range halls {
+		location := fmt.Sprintf("Hall %d", hall.Number)
+		if hall.CinemaID != nil {
+			cinema, ok := cinemas[*hall.CinemaID]
+			if !ok {
+				var err error
+				if cinema, err = s.cinemaRepo.GetById(*hall.CinemaID); err != nil {
+					return nil, err
+				}
+				cinemas[*hall.CinemaID] = cinema
+			}
+			location = joinNonEmpty(", ", cinema.Name, location, cinema.Address)
+		}
+		locations[hall.ID] = location
+	}
+	return locations, nil
+}
+
+// seatLabels turns the booked seat ids into "row X seat Y" labels in seat
+// order. Seats no longer in the hall fall back to their id.
+func seatLabels(seats []models.Seat, seatIDs []uint) []string {
+	booked := make(map[uint]bool, len(seatIDs))
+	for _, id := range seatIDs {
+		booked[id] = true
+	}
+
+	labels := make([]string, 0, len(seatIDs))
+	for _, seat := range seats {
+		if booked[seat.ID] {
+			labels = append(labels, fmt.Sprintf("row %d seat %d", seat.Row, seat.Number))
+			delete(booked, seat.ID)
+		}
+	}
+	for _, id := range seatIDs {
+		if booked[id] {
+			labels = append(labels, fmt.Sprintf("seat #%d", id))
+		}
+	}
+	return labels
+}
+
+func (s *sessionService) calendarName(query dto.CalendarQuery) (string, error) {
+	switch {
+	case query.MovieID != 0:
+		movie, err := clients.GetMovie(query.MovieID)
+		if err != nil {
+			return "", err
+		}
+		return movie.Title + " showtimes", nil
+	case query.HallID != 0:
+		hall, err := s.hallRepo.GetById(query.HallID)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Hall %d showtimes", hall.Number), nil
+	case query.CinemaID != 0:
+		cinema, err := s.cinemaRepo.GetById(query.CinemaID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return "", constants.ErrCinemaNotFound
+			}
+			return "", err
+		}
+		return cinema.Name + " showtimes", nil
+	}
+	return "", errors.New("movie, hall or cinema is required")
+}
+
+func sessionDescription(session models.Session) string {
+	parts := []string{strings.ToUpper(strings.ReplaceAll(string(session.Format), "_", " "))}
+	if session.DolbyAtmos {
+		parts = append(parts, "Dolby Atmos")
+	}
+	if session.AudioLanguage != "" {
+		parts = append(parts, "Audio: "+session.AudioLanguage)
+	}
+	if session.SubtitleLanguage != "" {
+		parts = append(parts, "Subtitles: "+session.SubtitleLanguage)
+	}
+	return joinNonEmpty("\n", parts...)
+}
+
+func joinNonEmpty(sep string, values ...string) string {
+	parts := make([]string, 0, len(values))
+	for _, value := range values {
+		if value != "" {
+			parts = append(parts, value)
+		}
+	}
+	return strings.Join(parts, sep)
+}
@@ -0,0 +1,277 @@
+package services
+
+import (
+	"cinema-service/internal/clients"
+	"cinema-service/internal/constants"
+	"cinema-service/internal/dto"
+	"cinema-service/internal/models"
+	"cinema-service/internal/repository"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func (s *sessionService) Search(query dto.SessionSearchQuery) ([]models.Session, int64, error) {
+
+	cinema, location, err := s.venue(query.CinemaID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	filter, err := buildSessionFilter(query, location)
+	if err != nil {
+		return nil, 0, err
+	}
+	if cinema != nil {
+		filter.CinemaID = cinema.ID
+		filter.Timezone = cinema.Timezone
+	}
+
+	sessions, total, err := s.sessionRepo.Search(filter)
+	if err != nil {
+		s.logger.Error(
+			"failed to search sessions",
+			"err", err,
+		)
+		return nil, 0, err
+	}
+
+	return sessions, total, nil
+}
+
+func (s *sessionService) Schedule(query dto.ScheduleQuery) (*dto.ScheduleResponse, error) {
+
+	_, location, err := s.venue(query.CinemaID)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.Date == "" {
+		query.Date = time.Now().In(location).Format(constants.FreeSlotDateLayout)
+	}
+
+	day, err := time.ParseInLocation(constants.FreeSlotDateLayout, query.Date, location)
+	if err != nil {
+		return nil, errors.New("date must be in YYYY-MM-DD format")
+	}
+	dayEnd := day.AddDate(0, 0, 1)
+
+	sessions, _, err := s.sessionRepo.Search(repository.SessionFilter{
+		CinemaID: query.CinemaID,
+		From:     &day,
+		To:       &dayEnd,
+		MovieID:  query.MovieID,
+		HallID:   query.HallID,
+		OrderBy:  "start_time",
+	})
+	if err != nil {
+		s.logger.Error(
+			"failed to list sessions for schedule",
+			"date", query.Date,
+			"err", err,
+		)
+		return nil, err
+	}
+
+	hallIDs := make([]uint, 0)
+	byMovie := make(map[uint]*dto.ScheduleMovie)
+	order := make([]uint, 0)
+	for _, session := range sessions {
+		if session.Status == models.SessionStatusCancelled {
+			continue
+		}
+
+		movie, ok := byMovie[session.MovieID]
+		if !ok {
+			movie = &dto.ScheduleMovie{MovieID: session.MovieID, Sessions: []dto.ScheduleSession{}}
+			byMovie[session.MovieID] = movie
+			order = append(order, session.MovieID)
+		}
+		movie.Sessions = append(movie.Sessions, dto.ScheduleSession{
+			SessionID:  session.ID,
+			HallID:     session.HallID,
+			StartTime:  session.StartTime,
+			EndTime:    session.EndTime,
+			Status:     string(session.Status),
+			Format:     string(session.Format),
+			DolbyAtmos: session.DolbyAtmos,
+			Audio:      session.AudioLanguage,
+			Subtitles:  session.SubtitleLanguage,
+		})
+		hallIDs = append(hallIDs, session.HallID)
+	}
+
+	halls, err := s.hallRepo.ListByIDs(hallIDs)
+	if err != nil {
+		return nil, err
+	}
+	hallNumbers := make(map[uint]int, len(halls))
+	for _, hall := range halls {
+		hallNumbers[hall.ID] = hall.Number
+	}
+
+	infos, err := clients.GetMovies(order)
+	if err != nil {
+		s.logger.Warn(
+			"failed to fetch movies for schedule",
+			"movie_ids", order,
+			"error", err,
+		)
+	}
+
+	movies := make([]dto.ScheduleMovie, 0, len(order))
+	for _, movieID := range order {
+		movie := byMovie[movieID]
+		for i := range movie.Sessions {
+			movie.Sessions[i].HallNumber = hallNumbers[movie.Sessions[i].HallID]
+		}
+
+		if info, ok := infos[movieID]; ok {
+			movie.Title = info.Title
+			movie.Duration = info.Duration
+		}
+
+		movies = append(movies, *movie)
+	}
+
+	sort.SliceStable(movies, func(i, j int) bool {
+		return movies[i].Title < movies[j].Title
+	})
+
+	return &dto.ScheduleResponse{
+		CinemaID: query.CinemaID,
+		Date:     query.Date,
+		Movies:   movies,
+	}, nil
+}
+
+// venue loads the cinema used to scope a query. Without a cinema, dates are
+// interpreted in the server's local time zone.
+func (s *sessionService) venue(cinemaID uint) (*models.Cinema, *time.Location, error) {
+	if cinemaID == 0 {
+		return nil, time.Local, nil
+	}
+
+	cinema, err := s.cinemaRepo.GetById(cinemaID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, constants.ErrCinemaNotFound
+		}
+		return nil, nil, err
+	}
+
+	return cinema, cinemaLocation(cinema), nil
+}
+
+func buildSessionFilter(query dto.SessionSearchQuery, location *time.Location) (repository.SessionFilter, error) {
+	ids, err := parseIDList(query.IDs)
+	if err != nil {
+		return repository.SessionFilter{}, err
+	}
+
+	filter := repository.SessionFilter{
+		IDs:     ids,
+		MovieID: query.MovieID,
+		HallID:  query.HallID,
+		Status:  query.Status,
+
+		Format:           query.Format,
+		DolbyAtmos:       query.DolbyAtmos,
+		AudioLanguage:    strings.ToLower(query.AudioLanguage),
+		SubtitleLanguage: strings.ToLower(query.SubtitleLanguage),
+	}
+
+	if query.Date != "" && (query.DateFrom != "" || query.DateTo != "") {
+		return filter, errors.New("date cannot be combined with date_from or date_to")
+	}
+
+	if query.Date != "" {
+		query.DateFrom = query.Date
+		query.DateTo = query.Date
+	}
+	if query.DateFrom != "" {
+		from, err := time.ParseInLocation(constants.FreeSlotDateLayout, query.DateFrom, location)
+		if err != nil {
+			return filter, errors.New("dates must be in YYYY-MM-DD format")
+		}
+		filter.From = &from
+	}
+	if query.DateTo != "" {
+		to, err := time.ParseInLocation(constants.FreeSlotDateLayout, query.DateTo, location)
+		if err != nil {
+			return filter, errors.New("dates must be in YYYY-MM-DD format")
+		}
+		to = to.AddDate(0, 0, 1)
+		filter.To = &to
+	}
+	if filter.From != nil && filter.To != nil && !filter.To.After(*filter.From) {
+		return filter, errors.New("date_from must not be after date_to")
+	}
+
+	for _, value := range []string{query.TimeFrom, query.TimeTo} {
+		if value == "" {
+			continue
+		}
+		if _, err := time.Parse(timeOfDayLayout, value); err != nil {
+			return filter, errors.New("time_from and time_to must be in HH:MM format")
+		}
+	}
+	filter.TimeFrom = query.TimeFrom
+	filter.TimeTo = query.TimeTo
+
+	sortBy := query.Sort
+	if sortBy == "" {
+		sortBy = constants.DefaultSessionSort
+	}
+	if column, found := strings.CutPrefix(sortBy, "-"); found {
+		filter.OrderBy = column + " DESC, id DESC"
+	} else {
+		filter.OrderBy = column + ", id"
+	}
+
+	// Paging is opt-in, callers that send neither page nor page_size still get
+	// every matching session.
+	if query.Page == 0 && query.PageSize == 0 {
+		return filter, nil
+	}
+
+	page := query.Page
+	if page == 0 {
+		page = 1
+	}
+	pageSize := query.PageSize
+	if pageSize == 0 {
+		pageSize = constants.DefaultSessionPageSize
+	}
+	filter.Limit = pageSize
+	filter.Offset = (page - 1) * pageSize
+
+	return filter, nil
+}
+
+// parseIDList reads the comma-separated ids of a batch lookup. An empty value
+// means no id filter.
+func parseIDList(value string) ([]uint, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(value, ",")
+	if len(parts) > constants.MaxBatchIDs {
+		return nil, constants.ErrInvalidIDList
+	}
+
+	ids := make([]uint, 0, len(parts))
+	for _, part := range parts {
+		id, err := strconv.ParseUint(strings.TrimSpace(part), 10, 32)
+		if err != nil || id == 0 {
+			return nil, constants.ErrInvalidIDList
+		}
+		ids = append(ids, uint(id))
+	}
+	return ids, nil
+}
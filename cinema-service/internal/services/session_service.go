@@ -1,11 +1,18 @@
 package services
 
 import (
+	"cinema-service/internal/clients"
+	"cinema-service/internal/config"
+	"cinema-service/internal/constants"
 	"cinema-service/internal/dto"
+	"cinema-service/internal/ics"
+	"cinema-service/internal/infrastructure"
 	"cinema-service/internal/models"
 	"cinema-service/internal/repository"
 	"errors"
+	"fmt"
 	"log/slog"
+	"slices"
 	"time"
 )
 
@@ -13,32 +20,58 @@ type SessionService interface {
 	Create(req dto.CreateSessionRequest) (*models.Session, error)
 	Update(id uint, req dto.UpdateSessionRequest) (*models.Session, error)
 	List() ([]models.Session, error)
+	Search(query dto.SessionSearchQuery) ([]models.Session, int64, error)
+	Schedule(query dto.ScheduleQuery) (*dto.ScheduleResponse, error)
 	GetById(id uint) (*models.Session, error)
-	Delete(id uint) error
+	Delete(id uint, force bool) error
 	ListByMovieID(movieID uint) ([]models.Session, error)
+	SeatMap(id uint) ([]dto.SessionSeatResponse, error)
+	FreeSlots(hallID uint, query dto.FreeSlotsQuery) ([]dto.FreeSlot, error)
+	AdvanceStatuses(now time.Time) error
+	Calendar(query dto.CalendarQuery) (string, []ics.Event, error)
+	BookingCalendar(sessionID uint, query dto.BookingCalendarQuery) (ics.Event, error)
 }
 
 type sessionService struct {
 	sessionRepo repository.SessionRepository
 	hallRepo    repository.HallRepository
+	cinemaRepo  repository.CinemaRepository
+	seatRepo    repository.SeatRepository
+	blockRepo   repository.SeatBlockRepository
+	groupRepo   repository.SeatGroupRepository
+	pricing     PricingService
+	events      infrastructure.SessionEventPublisher
 	logger      *slog.Logger
 }
 
 func NewSessionService(
 	sessionRepo repository.SessionRepository,
 	hallRepo repository.HallRepository,
+	cinemaRepo repository.CinemaRepository,
+	seatRepo repository.SeatRepository,
+	blockRepo repository.SeatBlockRepository,
+	groupRepo repository.SeatGroupRepository,
+	pricing PricingService,
+	events infrastructure.SessionEventPublisher,
 	logger *slog.Logger,
 ) SessionService {
 	return &sessionService{
 		sessionRepo: sessionRepo,
 		hallRepo:    hallRepo,
+		cinemaRepo:  cinemaRepo,
+		seatRepo:    seatRepo,
+		blockRepo:   blockRepo,
+		groupRepo:   groupRepo,
+		pricing:     pricing,
+		events:      events,
 		logger:      logger,
 	}
 }
 
 func (s *sessionService) Create(req dto.CreateSessionRequest) (*models.Session, error) {
 
-	if _, err := s.hallRepo.GetById(req.HallID); err != nil {
+	hall, err := s.hallRepo.GetById(req.HallID)
+	if err != nil {
 		s.logger.Warn(
 			"hall not found while creating session",
 			"hall_id", req.HallID,
@@ -47,6 +80,13 @@ func (s *sessionService) Create(req dto.CreateSessionRequest) (*models.Session,
 		return nil, err
 	}
 
+	if req.Format == "" {
+		req.Format = models.SessionFormat2D
+	}
+	if err := checkHallCapabilities(hall, req.Format, req.DolbyAtmos); err != nil {
+		return nil, err
+	}
+
 	if req.StartTime.Before(time.Now()) {
 		s.logger.Warn(
 			"attempt to create session in the past",
@@ -57,15 +97,44 @@ func (s *sessionService) Create(req dto.CreateSessionRequest) (*models.Session,
 		return nil, errors.New("start_time must be in the future")
 	}
 
+	movie, err := activeMovie(req.MovieID, s.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.EndTime.IsZero() {
+		req.EndTime = sessionEndTime(req.StartTime, movie)
+	}
+
 	session := &models.Session{
 		MovieID:   req.MovieID,
 		HallID:    req.HallID,
 		StartTime: req.StartTime,
 		EndTime:   req.EndTime,
 		Status:    models.SessionStatusScheduled,
+
+		Format:           req.Format,
+		DolbyAtmos:       req.DolbyAtmos,
+		AudioLanguage:    req.AudioLanguage,
+		SubtitleLanguage: req.SubtitleLanguage,
+
+		SalesOpenAt:   req.SalesOpenAt,
+		SalesCloseAt:  req.SalesCloseAt,
+		PresaleOpenAt: req.PresaleOpenAt,
+	}
+
+	cinema, err := hallCinema(s.cinemaRepo, hall)
+	if err != nil {
+		return nil, err
+	}
+	applySalesDefaults(session, cinema)
+	if err := validateSalesWindow(session); err != nil {
+		return nil, err
 	}
 
-	if err := s.sessionRepo.Create(session); err != nil {
+	from, to := overlapWindow(session.StartTime, session.EndTime)
+	conflicts, err := s.sessionRepo.CreateInFreeSlot(session, from, to)
+	if err != nil {
 		s.logger.Error(
 			"failed to create session",
 			"hall_id", req.HallID,
@@ -76,6 +145,9 @@ func (s *sessionService) Create(req dto.CreateSessionRequest) (*models.Session,
 		)
 		return nil, err
 	}
+	if err := s.overlapError(session, conflicts); err != nil {
+		return nil, err
+	}
 
 	return session, nil
 }
@@ -93,10 +165,17 @@ func (s *sessionService) Update(id uint, req dto.UpdateSessionRequest) (*models.
 	}
 
 	if req.StartTime != nil {
+		shiftSalesWindow(session, req.StartTime.Sub(session.StartTime))
 		session.StartTime = *req.StartTime
 	}
 	if req.EndTime != nil {
 		session.EndTime = *req.EndTime
+	} else if req.StartTime != nil {
+		movie, err := activeMovie(session.MovieID, s.logger)
+		if err != nil {
+			return nil, err
+		}
+		session.EndTime = sessionEndTime(session.StartTime, movie)
 	}
 
 	if session.EndTime.Before(session.StartTime) || session.EndTime.Equal(session.StartTime) {
@@ -109,11 +188,68 @@ func (s *sessionService) Update(id uint, req dto.UpdateSessionRequest) (*models.
 		return nil, errors.New("end_time must be after start_time")
 	}
 
+	if req.Format != nil || req.DolbyAtmos != nil {
+		if req.Format != nil {
+			session.Format = *req.Format
+		}
+		if req.DolbyAtmos != nil {
+			session.DolbyAtmos = *req.DolbyAtmos
+		}
+
+		hall, err := s.hallRepo.GetById(session.HallID)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkHallCapabilities(hall, session.Format, session.DolbyAtmos); err != nil {
+			return nil, err
+		}
+	}
+	if req.AudioLanguage != nil {
+		session.AudioLanguage = *req.AudioLanguage
+	}
+	if req.SubtitleLanguage != nil {
+		session.SubtitleLanguage = *req.SubtitleLanguage
+	}
+
+	if req.ClearSalesWindow {
+		session.SalesOpenAt, session.SalesCloseAt, session.PresaleOpenAt = nil, nil, nil
+	}
+	if req.SalesOpenAt != nil {
+		session.SalesOpenAt = req.SalesOpenAt
+	}
+	if req.SalesCloseAt != nil {
+		session.SalesCloseAt = req.SalesCloseAt
+	}
+	if req.PresaleOpenAt != nil {
+		session.PresaleOpenAt = req.PresaleOpenAt
+	}
+	if err := validateSalesWindow(session); err != nil {
+		return nil, err
+	}
+
+	oldStatus := session.Status
 	if req.Status != nil {
-		session.Status = models.SessionStatus(*req.Status)
+		newStatus := models.SessionStatus(*req.Status)
+		if !oldStatus.CanTransitionTo(newStatus) {
+			s.logger.Warn(
+				"invalid session status transition",
+				"session_id", id,
+				"from", oldStatus,
+				"to", newStatus,
+			)
+			return nil, fmt.Errorf("%w: %s -> %s", constants.ErrInvalidStatusTransition, oldStatus, newStatus)
+		}
+		session.Status = newStatus
 	}
 
-	if err := s.sessionRepo.Update(id, session); err != nil {
+	var conflicts []uint
+	if session.Status != models.SessionStatusCancelled && (req.StartTime != nil || req.EndTime != nil || req.Status != nil) {
+		from, to := overlapWindow(session.StartTime, session.EndTime)
+		conflicts, err = s.sessionRepo.UpdateInFreeSlot(id, session, from, to)
+	} else {
+		err = s.sessionRepo.Update(id, session)
+	}
+	if err != nil {
 		s.logger.Error(
 			"failed to update session",
 			"session_id", id,
@@ -123,6 +259,13 @@ func (s *sessionService) Update(id uint, req dto.UpdateSessionRequest) (*models.
 		)
 		return nil, err
 	}
+	if err := s.overlapError(session, conflicts); err != nil {
+		return nil, err
+	}
+
+	if session.Status != oldStatus {
+		publishStatusChanged(s.events, s.logger, *session, oldStatus)
+	}
 
 	return session, nil
 }
@@ -156,7 +299,7 @@ func (s *sessionService) GetById(id uint) (*models.Session, error) {
 	return session, nil
 }
 
-func (s *sessionService) Delete(id uint) error {
+func (s *sessionService) Delete(id uint, force bool) error {
 
 	if _, err := s.sessionRepo.GetById(id); err != nil {
 		s.logger.Warn(
@@ -167,6 +310,11 @@ func (s *sessionService) Delete(id uint) error {
 		return err
 	}
 
+	if err := clearActiveBookings(s.events, constants.RevokeReasonSessionDeleted, []uint{id}, nil, force); err != nil {
+		s.logger.Warn("session has active bookings", "session_id", id, "err", err)
+		return err
+	}
+
 	if err := s.sessionRepo.Delete(id); err != nil {
 		s.logger.Error(
 			"failed to delete session",
@@ -193,3 +341,276 @@ func (s *sessionService) ListByMovieID(movieID uint) ([]models.Session, error) {
 
 	return sessions, nil
 }
+
+func (s *sessionService) SeatMap(id uint) ([]dto.SessionSeatResponse, error) {
+
+	session, err := s.sessionRepo.GetById(id)
+	if err != nil {
+		s.logger.Warn(
+			"session not found",
+			"session_id", id,
+			"error", err,
+		)
+		return nil, err
+	}
+
+	seats, err := s.seatRepo.ListByHallID(session.HallID)
+	if err != nil {
+		s.logger.Error(
+			"failed to list seats for session",
+			"session_id", id,
+			"hall_id", session.HallID,
+			"err", err,
+		)
+		return nil, err
+	}
+
+	prices, err := s.pricing.PriceSeats(session, seats)
+	if err != nil {
+		s.logger.Error(
+			"failed to price seats for session",
+			"session_id", id,
+			"err", err,
+		)
+		return nil, err
+	}
+
+	blocks, err := s.blockRepo.ListBySessionID(id)
+	if err != nil {
+		return nil, err
+	}
+	blocked := make(map[uint]models.SeatBlockReason, len(blocks))
+	for _, block := range blocks {
+		blocked[block.SeatID] = block.Reason
+	}
+
+	groupIDs := make([]uint, 0)
+	for _, seat := range seats {
+		if seat.GroupID != nil && !slices.Contains(groupIDs, *seat.GroupID) {
+			groupIDs = append(groupIDs, *seat.GroupID)
+		}
+	}
+	groups, err := s.groupRepo.ListByIDs(groupIDs)
+	if err != nil {
+		return nil, err
+	}
+	sellTogether := make(map[uint]bool, len(groups))
+	for _, group := range groups {
+		sellTogether[group.ID] = group.SellTogether
+	}
+
+	seatMap := make([]dto.SessionSeatResponse, 0, len(seats))
+	for _, seat := range seats {
+		reason, isBlocked := blocked[seat.ID]
+		response := dto.SessionSeatResponse{
+			ID:             seat.ID,
+			Row:            seat.Row,
+			Number:         seat.Number,
+			Type:           seat.Type,
+			Price:          prices[seat.ID][models.TicketAdult],
+			CategoryPrices: prices[seat.ID],
+			Blocked:        isBlocked,
+			BlockReason:    reason,
+			X:              seat.X,
+			Y:              seat.Y,
+			Section:        seat.Section,
+			GroupID:        seat.GroupID,
+		}
+		if seat.GroupID != nil {
+			response.SellTogether = sellTogether[*seat.GroupID]
+		}
+		seatMap = append(seatMap, response)
+	}
+
+	return seatMap, nil
+}
+
+func (s *sessionService) overlapError(session *models.Session, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	s.logger.Warn(
+		"session overlaps with existing sessions",
+		"hall_id", session.HallID,
+		"start_time", session.StartTime,
+		"end_time", session.EndTime,
+		"conflicting_ids", ids,
+	)
+
+	return &constants.SessionOverlapError{ConflictingIDs: ids}
+}
+
+// overlapWindow widens a session by the cleaning buffer on both sides, so
+// back-to-back shows always leave the hall time to be cleaned.
+func overlapWindow(start, end time.Time) (time.Time, time.Time) {
+	buffer := config.CleaningBuffer()
+	return start.Add(-buffer), end.Add(buffer)
+}
+
+func overlappingSessionIDs(sessionRepo repository.SessionRepository, hallID uint, start, end time.Time, excludeID uint) ([]uint, error) {
+	from, to := overlapWindow(start, end)
+
+	conflicts, err := sessionRepo.FindOverlapping(hallID, from, to, excludeID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		ids = append(ids, conflict.ID)
+	}
+	return ids, nil
+}
+
+func (s *sessionService) FreeSlots(hallID uint, query dto.FreeSlotsQuery) ([]dto.FreeSlot, error) {
+
+	hall, err := s.hallRepo.GetById(hallID)
+	if err != nil {
+		return nil, err
+	}
+
+	var cinema *models.Cinema
+	location := time.Local
+	if hall.CinemaID != nil {
+		if cinema, err = s.cinemaRepo.GetById(*hall.CinemaID); err != nil {
+			return nil, err
+		}
+		location = cinemaLocation(cinema)
+	}
+
+	day, err := time.ParseInLocation(constants.FreeSlotDateLayout, query.Date, location)
+	if err != nil {
+		return nil, errors.New("date must be in YYYY-MM-DD format")
+	}
+	dayStart, dayEnd := day, day.AddDate(0, 0, 1)
+
+	// a venue with opening hours only has free slots while it is open
+	if cinema != nil && len(cinema.OpeningHours) > 0 {
+		from, to, open := openingWindow(cinema, day)
+		if !open {
+			return []dto.FreeSlot{}, nil
+		}
+		dayStart, dayEnd = from, to
+	}
+
+	buffer := config.CleaningBuffer()
+	minDuration := time.Duration(query.MinDuration) * time.Minute
+
+	sessions, err := s.sessionRepo.FindOverlapping(hallID, dayStart.Add(-buffer), dayEnd.Add(buffer), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	slots := make([]dto.FreeSlot, 0)
+	cursor := dayStart
+	addSlot := func(end time.Time) {
+		if end.After(dayEnd) {
+			end = dayEnd
+		}
+		if end.Sub(cursor) <= 0 || end.Sub(cursor) < minDuration {
+			return
+		}
+		slots = append(slots, dto.FreeSlot{
+			Start:   cursor,
+			End:     end,
+			Minutes: int(end.Sub(cursor).Minutes()),
+		})
+	}
+
+	for _, session := range sessions {
+		addSlot(session.StartTime.Add(-buffer))
+		if busyUntil := session.EndTime.Add(buffer); busyUntil.After(cursor) {
+			cursor = busyUntil
+		}
+	}
+	addSlot(dayEnd)
+
+	return slots, nil
+}
+
+// checkHallCapabilities rejects formats the hall has no equipment for.
+func checkHallCapabilities(hall *models.Hall, format models.SessionFormat, dolbyAtmos bool) error {
+	needs3D := format == models.SessionFormat3D || format == models.SessionFormatIMAX3D
+	needsIMAX := format == models.SessionFormatIMAX || format == models.SessionFormatIMAX3D
+
+	switch {
+	case needs3D && !hall.Supports3D:
+		return fmt.Errorf("%w: hall %d has no 3D projection", constants.ErrHallCapability, hall.ID)
+	case needsIMAX && !hall.SupportsIMAX:
+		return fmt.Errorf("%w: hall %d is not an IMAX hall", constants.ErrHallCapability, hall.ID)
+	case dolbyAtmos && !hall.SupportsDolbyAtmos:
+		return fmt.Errorf("%w: hall %d has no Dolby Atmos sound", constants.ErrHallCapability, hall.ID)
+	}
+
+	return nil
+}
+
+func activeMovie(movieID uint, logger *slog.Logger) (*dto.MovieResponse, error) {
+	movie, err := clients.GetMovie(movieID)
+	if err != nil {
+		logger.Warn(
+			"failed to fetch movie for session",
+			"movie_id", movieID,
+			"error", err,
+		)
+		return nil, err
+	}
+
+	if movie.MovieStatus == constants.MovieStatusEnded {
+		return nil, constants.ErrMovieEnded
+	}
+
+	return movie, nil
+}
+
+func sessionEndTime(start time.Time, movie *dto.MovieResponse) time.Time {
+	return start.Add(config.AdsDuration() + time.Duration(movie.Duration)*time.Minute)
+}
+
+// AdvanceStatuses moves sessions along the lifecycle by the clock: scheduled
+// sessions become ongoing once they start and finished once they end.
+func (s *sessionService) AdvanceStatuses(now time.Time) error {
+
+	sessions, err := s.sessionRepo.ListDueForStatusChange(now)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		oldStatus := session.Status
+		newStatus := models.SessionStatusOngoing
+		if !session.EndTime.After(now) {
+			newStatus = models.SessionStatusFinished
+		}
+
+		updated, err := s.sessionRepo.UpdateStatus(session.ID, oldStatus, newStatus)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			continue
+		}
+
+		session.Status = newStatus
+		s.logger.Info(
+			"session status advanced",
+			"session_id", session.ID,
+			"from", oldStatus,
+			"to", newStatus,
+		)
+		publishStatusChanged(s.events, s.logger, session, oldStatus)
+	}
+
+	return nil
+}
+
+func publishStatusChanged(events infrastructure.SessionEventPublisher, logger *slog.Logger, session models.Session, oldStatus models.SessionStatus) {
+	if err := events.PublishStatusChanged(session, oldStatus); err != nil {
+		logger.Error(
+			"failed to publish session status event",
+			"session_id", session.ID,
+			"err", err,
+		)
+	}
+}
@@ -0,0 +1,145 @@
+package transport
+
+import (
+	"cinema-service/internal/constants"
+	"cinema-service/internal/dto"
+	"cinema-service/internal/services"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type CinemaHandler struct {
+	cinemaService services.CinemaService
+	logger        *slog.Logger
+}
+
+func NewCinemaHandler(cinemaService services.CinemaService, logger *slog.Logger) *CinemaHandler {
+	return &CinemaHandler{
+		cinemaService: cinemaService,
+		logger:        logger,
+	}
+}
+
+func (h *CinemaHandler) RegisterRoutes(r *gin.Engine) {
+	cinemas := r.Group("/cinemas")
+	{
+		cinemas.GET("", h.List)
+		cinemas.GET("/:id", h.GetById)
+		cinemas.POST("", h.Create)
+		cinemas.PATCH("/:id", h.Update)
+		cinemas.DELETE("/:id", h.Delete)
+	}
+}
+
+func (h *CinemaHandler) Create(c *gin.Context) {
+	var req dto.CreateCinemaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("handler: failed to bind JSON", "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cinema, err := h.cinemaService.Create(req)
+	if err != nil {
+		h.logger.Error("failed to create cinema", "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, cinema)
+}
+
+func (h *CinemaHandler) List(c *gin.Context) {
+	cinemas, err := h.cinemaService.List()
+	if err != nil {
+		h.logger.Error("failed to list cinemas", "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to list cinemas"})
+		return
+	}
+
+	c.JSON(http.StatusOK, cinemas)
+}
+
+func (h *CinemaHandler) GetById(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	cinema, err := h.cinemaService.GetById(uint(id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "cinema not found"})
+			return
+		}
+
+		h.logger.Error("failed to fetch cinema", "id", id, "err", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch cinema"})
+		return
+	}
+
+	c.JSON(http.StatusOK, cinema)
+}
+
+func (h *CinemaHandler) Update(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req dto.UpdateCinemaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("handler: failed to bind JSON", "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cinema, err := h.cinemaService.Update(uint(id), req)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "cinema not found"})
+			return
+		}
+
+		h.logger.Error("failed to update cinema", "id", id, "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, cinema)
+}
+
+func (h *CinemaHandler) Delete(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	if err := h.cinemaService.Delete(uint(id)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "cinema not found"})
+			return
+		}
+		if errors.Is(err, constants.ErrCinemaHasHalls) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+
+		h.logger.Error("failed to delete cinema", "id", id, "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "cinema deleted successfully"})
+}
@@ -1,13 +1,16 @@
 package transport
 
 import (
+	"cinema-service/internal/constants"
 	"cinema-service/internal/dto"
 	"cinema-service/internal/services"
+	"errors"
 	"log/slog"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 )
 
 type HallHandler struct {
@@ -42,6 +45,9 @@ func (h *HallHandler) Create(c *gin.Context) {
 	}
 	hall, err := h.hallService.CreateHall(req)
 	if err != nil {
+		if respondHallError(c, err) {
+			return
+		}
 		h.logger.Error("handler: failed to create hall", "err", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -64,6 +70,9 @@ func (h *HallHandler) Patch(c *gin.Context) {
 	}
 	hall, err := h.hallService.UpdateHall(uint(id), req)
 	if err != nil {
+		if respondHallError(c, err) {
+			return
+		}
 		h.logger.Error("failed to fetch halls")
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
@@ -88,8 +97,18 @@ func (h *HallHandler) GetById(c *gin.Context) {
 }
 
 func (h *HallHandler) GetAllHalls(c *gin.Context) {
-	halls, err := h.hallService.ListHall()
+	var query dto.HallListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	halls, err := h.hallService.ListHall(query)
 	if err != nil {
+		if errors.Is(err, constants.ErrInvalidIDList) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		h.logger.Error("failed to fetch halls", "err", err)
 		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to fetch halls"})
 		return
@@ -105,11 +124,35 @@ func (h *HallHandler) RemoveHall(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
 		return
 	}
-	if err := h.hallService.DeleteHall(uint(id)); err != nil {
-		h.logger.Error("handler: failed to delete hall", "id", id)
-		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to delete hall"})
+	var query dto.DeleteQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := h.hallService.DeleteHall(uint(id), query.Force); err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "hall not found"})
+		case errors.Is(err, constants.ErrActiveBookings):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			h.logger.Error("handler: failed to delete hall", "id", id, "err", err)
+			c.JSON(http.StatusBadRequest, gin.H{"error": "failed to delete hall"})
+		}
 		return
 	}
 	h.logger.Info("handler: hall deleted successfully", "id", id)
 	c.JSON(http.StatusOK, gin.H{"message": "hall deleted successfully"})
 }
+
+func respondHallError(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, constants.ErrCinemaNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrHallNumberTaken):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		return false
+	}
+	return true
+}
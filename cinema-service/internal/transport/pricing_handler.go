@@ -0,0 +1,210 @@
+package transport
+
+import (
+	"cinema-service/internal/dto"
+	"cinema-service/internal/services"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type PricingHandler struct {
+	pricingService services.PricingService
+	logger         *slog.Logger
+}
+
+func NewPricingHandler(pricingService services.PricingService, logger *slog.Logger) *PricingHandler {
+	return &PricingHandler{
+		pricingService: pricingService,
+		logger:         logger,
+	}
+}
+
+func (h *PricingHandler) RegisterRoutes(r *gin.Engine) {
+	pricing := r.Group("/pricing")
+	{
+		pricing.GET("/rules", h.ListRules)
+		pricing.GET("/rules/:id", h.GetRule)
+		pricing.POST("/rules", h.CreateRule)
+		pricing.PUT("/rules/:id", h.UpdateRule)
+		pricing.DELETE("/rules/:id", h.DeleteRule)
+		pricing.GET("/holidays", h.ListHolidays)
+		pricing.POST("/holidays", h.CreateHoliday)
+		pricing.DELETE("/holidays/:id", h.DeleteHoliday)
+		pricing.GET("/preview", h.Preview)
+	}
+}
+
+func (h *PricingHandler) CreateRule(c *gin.Context) {
+	var req dto.PriceRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("handler: failed to bind JSON", "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rule, err := h.pricingService.CreateRule(req)
+	if err != nil {
+		h.logger.Error("failed to create price rule", "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, rule)
+}
+
+func (h *PricingHandler) ListRules(c *gin.Context) {
+	rules, err := h.pricingService.ListRules()
+	if err != nil {
+		h.logger.Error("failed to list price rules", "err", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list price rules"})
+		return
+	}
+
+	c.JSON(http.StatusOK, rules)
+}
+
+func (h *PricingHandler) GetRule(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	rule, err := h.pricingService.GetRule(uint(id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "price rule not found"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, rule)
+}
+
+func (h *PricingHandler) UpdateRule(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req dto.PriceRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("handler: failed to bind JSON", "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rule, err := h.pricingService.UpdateRule(uint(id), req)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "price rule not found"})
+			return
+		}
+		h.logger.Error("failed to update price rule", "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, rule)
+}
+
+func (h *PricingHandler) DeleteRule(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	if err := h.pricingService.DeleteRule(uint(id)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "price rule not found"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "price rule deleted successfully"})
+}
+
+func (h *PricingHandler) CreateHoliday(c *gin.Context) {
+	var req dto.CreateHolidayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("handler: failed to bind JSON", "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	holiday, err := h.pricingService.CreateHoliday(req)
+	if err != nil {
+		h.logger.Error("failed to create holiday", "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, holiday)
+}
+
+func (h *PricingHandler) ListHolidays(c *gin.Context) {
+	holidays, err := h.pricingService.ListHolidays()
+	if err != nil {
+		h.logger.Error("failed to list holidays", "err", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list holidays"})
+		return
+	}
+
+	c.JSON(http.StatusOK, holidays)
+}
+
+func (h *PricingHandler) DeleteHoliday(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	if err := h.pricingService.DeleteHoliday(uint(id)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "holiday not found"})
+			return
+		}
+		h.logger.Error("failed to delete holiday", "err", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete holiday"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "holiday deleted successfully"})
+}
+
+func (h *PricingHandler) Preview(c *gin.Context) {
+	var query dto.PricePreviewQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	preview, err := h.pricingService.Preview(query.SessionID, query.SeatID, query.Category)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "session or seat not found"})
+			return
+		}
+		h.logger.Error("failed to preview price", "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, preview)
+}
@@ -10,17 +10,29 @@ import (
 func RegisterRoutes(
 	router *gin.Engine,
 	logger *slog.Logger,
+	cinemaService services.CinemaService,
 	hallService services.HallService,
 	seatService services.SeatService,
 	sessionsService services.SessionService,
+	pricingService services.PricingService,
+	templateService services.ScheduleTemplateService,
+	seatBlockService services.SeatBlockService,
 
 ) {
 
+	cinemaHandler := NewCinemaHandler(cinemaService, logger)
 	hallHandler := NewHallHandler(hallService, logger)
 	seatHandler := NewSeatHandler(seatService, logger)
 	sessionHandler := NewSessionHandler(sessionsService, logger)
+	pricingHandler := NewPricingHandler(pricingService, logger)
+	templateHandler := NewScheduleTemplateHandler(templateService, logger)
+	seatBlockHandler := NewSeatBlockHandler(seatBlockService, logger)
 
+	cinemaHandler.RegisterRoutes(router)
 	hallHandler.RegisterRoutes(router)
 	seatHandler.RegisterRoutes(router)
 	sessionHandler.RegisterRoutes(router)
+	pricingHandler.RegisterRoutes(router)
+	templateHandler.RegisterRoutes(router)
+	seatBlockHandler.RegisterRoutes(router)
 }
@@ -0,0 +1,200 @@
+package transport
+
+import (
+	"cinema-service/internal/constants"
+	"cinema-service/internal/dto"
+	"cinema-service/internal/services"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type ScheduleTemplateHandler struct {
+	templateService services.ScheduleTemplateService
+	logger          *slog.Logger
+}
+
+func NewScheduleTemplateHandler(templateService services.ScheduleTemplateService, logger *slog.Logger) *ScheduleTemplateHandler {
+	return &ScheduleTemplateHandler{
+		templateService: templateService,
+		logger:          logger,
+	}
+}
+
+func (h *ScheduleTemplateHandler) RegisterRoutes(r *gin.Engine) {
+	templates := r.Group("/schedule-templates")
+	{
+		templates.GET("", h.List)
+		templates.GET("/:id", h.GetById)
+		templates.POST("", h.Create)
+		templates.DELETE("/:id", h.Delete)
+		templates.POST("/:id/generate", h.Generate)
+		templates.POST("/:id/cancel", h.Cancel)
+		templates.POST("/:id/shift", h.Shift)
+	}
+}
+
+func (h *ScheduleTemplateHandler) Create(c *gin.Context) {
+	var req dto.ScheduleTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("handler: failed to bind JSON", "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	template, err := h.templateService.Create(req)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "hall not found"})
+			return
+		}
+		if errors.Is(err, constants.ErrMovieNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+
+		h.logger.Error("failed to create schedule template", "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, template)
+}
+
+func (h *ScheduleTemplateHandler) List(c *gin.Context) {
+	templates, err := h.templateService.List()
+	if err != nil {
+		h.logger.Error("failed to list schedule templates", "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to list schedule templates"})
+		return
+	}
+
+	c.JSON(http.StatusOK, templates)
+}
+
+func (h *ScheduleTemplateHandler) GetById(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	template, err := h.templateService.GetById(uint(id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "schedule template not found"})
+			return
+		}
+
+		h.logger.Error("failed to fetch schedule template", "id", id, "err", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch schedule template"})
+		return
+	}
+
+	c.JSON(http.StatusOK, template)
+}
+
+func (h *ScheduleTemplateHandler) Delete(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	if err := h.templateService.Delete(uint(id)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "schedule template not found"})
+			return
+		}
+
+		h.logger.Error("failed to delete schedule template", "id", id, "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "schedule template deleted successfully"})
+}
+
+func (h *ScheduleTemplateHandler) Generate(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var query dto.TemplateGenerateQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.templateService.Generate(uint(id), query.DryRun)
+	if err != nil {
+		h.respondError(c, uint(id), err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *ScheduleTemplateHandler) Cancel(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	result, err := h.templateService.Cancel(uint(id))
+	if err != nil {
+		h.respondError(c, uint(id), err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *ScheduleTemplateHandler) Shift(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req dto.TemplateShiftRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("handler: failed to bind JSON", "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.templateService.Shift(uint(id), req)
+	if err != nil {
+		h.respondError(c, uint(id), err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *ScheduleTemplateHandler) respondError(c *gin.Context, id uint, err error) {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "schedule template not found"})
+		return
+	}
+	if errors.Is(err, constants.ErrMovieNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.logger.Error("failed to run schedule template", "id", id, "err", err)
+	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
@@ -0,0 +1,118 @@
+package transport
+
+import (
+	"cinema-service/internal/constants"
+	"cinema-service/internal/dto"
+	"cinema-service/internal/services"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type SeatBlockHandler struct {
+	seatBlockService services.SeatBlockService
+	logger           *slog.Logger
+}
+
+func NewSeatBlockHandler(seatBlockService services.SeatBlockService, logger *slog.Logger) *SeatBlockHandler {
+	return &SeatBlockHandler{
+		seatBlockService: seatBlockService,
+		logger:           logger,
+	}
+}
+
+func (h *SeatBlockHandler) RegisterRoutes(r *gin.Engine) {
+	blocks := r.Group("/sessions/:id/blocks")
+	{
+		blocks.GET("", h.List)
+		blocks.POST("", h.Block)
+		blocks.DELETE("/:seat_id", h.Unblock)
+	}
+}
+
+func (h *SeatBlockHandler) List(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	blocks, err := h.seatBlockService.List(uint(id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "session not found"})
+			return
+		}
+		h.logger.Error("failed to list seat blocks", "session_id", id, "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, blocks)
+}
+
+func (h *SeatBlockHandler) Block(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req dto.SeatBlockRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("handler: failed to bind JSON", "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	blocks, err := h.seatBlockService.Block(uint(id), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "session not found"})
+		case errors.Is(err, constants.ErrSeatBooked), errors.Is(err, constants.ErrSessionClosed):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			h.logger.Error("failed to block seats", "session_id", id, "err", err)
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
+		return
+	}
+	c.JSON(http.StatusCreated, blocks)
+}
+
+func (h *SeatBlockHandler) Unblock(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+	seatIDStr := c.Param("seat_id")
+	seatID, err := strconv.ParseUint(seatIDStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid seat id"})
+		return
+	}
+
+	if err := h.seatBlockService.Unblock(uint(id), uint(seatID)); err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "session not found"})
+		case errors.Is(err, constants.ErrSeatBlockNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case errors.Is(err, constants.ErrSessionClosed):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			h.logger.Error("failed to unblock seat", "session_id", id, "seat_id", seatID, "err", err)
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "seat unblocked"})
+}
@@ -1,13 +1,20 @@
 package transport
 
 import (
+	"cinema-service/internal/constants"
 	"cinema-service/internal/dto"
 	"cinema-service/internal/services"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 )
 
 type SeatHandler struct {
@@ -26,9 +33,16 @@ func (h *SeatHandler) RegisterRoutes(r *gin.Engine) {
 	seats := r.Group("/")
 	{
 		seats.POST("/halls/:id/seats", h.Create)
+		seats.GET("/halls/:id/seats", h.ListByHall)
+		seats.PUT("/halls/:id/layout", h.GenerateLayout)
+		seats.GET("/halls/:id/layout/export", h.ExportLayout)
+		seats.POST("/halls/:id/layout/import", h.ImportLayout)
 		seats.GET("/seats", h.GetAllSeats)
 		seats.PATCH("/seats/:id", h.Patch)
 		seats.DELETE("/seats/:id", h.RemoveSeat)
+		seats.POST("/halls/:id/seat-groups", h.CreateGroup)
+		seats.GET("/halls/:id/seat-groups", h.ListGroups)
+		seats.DELETE("/seat-groups/:id", h.DeleteGroup)
 	}
 }
 
@@ -96,11 +110,298 @@ func (h *SeatHandler) RemoveSeat(c *gin.Context) {
 		return
 	}
 
-	if err := h.seatService.Delete(uint(id)); err != nil {
-		h.logger.Error("handler: failed to delete seat", "id", id)
-		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to delete seat"})
+	var query dto.DeleteQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := h.seatService.Delete(uint(id), query.Force); err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "seat not found"})
+		case errors.Is(err, constants.ErrActiveBookings):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			h.logger.Error("handler: failed to delete seat", "id", id, "err", err)
+			c.JSON(http.StatusBadRequest, gin.H{"error": "failed to delete seat"})
+		}
 		return
 	}
 	h.logger.Info("handler: seat deleted successfully", "id", id)
 	c.JSON(http.StatusOK, gin.H{"message": "seat deleted successfully"})
 }
+
+func (h *SeatHandler) GenerateLayout(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req dto.HallLayoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("handler: failed to bind JSON", "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rows, err := h.seatService.GenerateLayout(uint(id), req)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "hall not found"})
+			return
+		}
+		if errors.Is(err, constants.ErrActiveBookings) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		h.logger.Error("failed to generate hall layout", "hall_id", id, "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, rows)
+}
+
+func (h *SeatHandler) ListByHall(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	rows, err := h.seatService.ListByRow(uint(id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "hall not found"})
+			return
+		}
+		h.logger.Error("failed to list hall seats", "hall_id", id, "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to list hall seats"})
+		return
+	}
+	c.JSON(http.StatusOK, rows)
+}
+
+func (h *SeatHandler) ExportLayout(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "csv" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "format must be json or csv"})
+		return
+	}
+
+	seats, err := h.seatService.ExportLayout(uint(id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "hall not found"})
+			return
+		}
+		h.logger.Error("failed to export hall layout", "hall_id", id, "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to export hall layout"})
+		return
+	}
+
+	if format == "json" {
+		layout := make([]dto.CreateSeatRequest, 0, len(seats))
+		for _, seat := range seats {
+			layout = append(layout, dto.CreateSeatRequest{
+				Row:     seat.Row,
+				Number:  seat.Number,
+				Type:    seat.Type,
+				X:       seat.X,
+				Y:       seat.Y,
+				Section: seat.Section,
+			})
+		}
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=hall-%d-layout.json", id))
+		c.JSON(http.StatusOK, layout)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=hall-%d-layout.csv", id))
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Status(http.StatusOK)
+
+	writer := csv.NewWriter(c.Writer)
+	_ = writer.Write(services.LayoutCSVHeader)
+	for _, seat := range seats {
+		_ = writer.Write([]string{
+			strconv.Itoa(seat.Row),
+			strconv.Itoa(seat.Number),
+			string(seat.Type),
+			formatCoordinate(seat.X),
+			formatCoordinate(seat.Y),
+			seat.Section,
+		})
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		h.logger.Error("failed to write hall layout csv", "hall_id", id, "err", err)
+	}
+}
+
+func (h *SeatHandler) ImportLayout(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var query dto.LayoutImportQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	body, filename, err := importBody(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	defer body.Close()
+
+	format := query.Format
+	if format == "" {
+		format = "json"
+		if strings.Contains(c.ContentType(), "csv") || strings.HasSuffix(strings.ToLower(filename), ".csv") {
+			format = "csv"
+		}
+	}
+
+	var rows []dto.SeatImportRow
+	if format == "csv" {
+		rows, err = services.ParseLayoutCSV(body)
+	} else {
+		rows, err = services.ParseLayoutJSON(body)
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to parse " + format + ": " + err.Error()})
+		return
+	}
+
+	result, err := h.seatService.ImportLayout(uint(id), rows, query.Mode, query.DryRun)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "hall not found"})
+			return
+		}
+		if errors.Is(err, constants.ErrActiveBookings) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		h.logger.Error("failed to import hall layout", "hall_id", id, "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if len(result.Errors) > 0 && !result.DryRun {
+		c.JSON(http.StatusUnprocessableEntity, result)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// importBody accepts the layout either as a multipart "file" field or as the
+// raw request body.
+func importBody(c *gin.Context) (io.ReadCloser, string, error) {
+	if strings.HasPrefix(c.ContentType(), "multipart/form-data") {
+		header, err := c.FormFile("file")
+		if err != nil {
+			return nil, "", errors.New("file is required")
+		}
+		file, err := header.Open()
+		if err != nil {
+			return nil, "", err
+		}
+		return file, header.Filename, nil
+	}
+	return c.Request.Body, "", nil
+}
+
+func formatCoordinate(value *float64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*value, 'f', -1, 64)
+}
+
+func (h *SeatHandler) CreateGroup(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req dto.CreateSeatGroupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("handler: failed to bind JSON", "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	group, err := h.seatService.CreateGroup(uint(id), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "hall not found"})
+		case errors.Is(err, constants.ErrSeatAlreadyGrouped), errors.Is(err, constants.ErrSeatGroupNameTaken):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			h.logger.Error("failed to create seat group", "hall_id", id, "err", err)
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
+		return
+	}
+	c.JSON(http.StatusCreated, group)
+}
+
+func (h *SeatHandler) ListGroups(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	groups, err := h.seatService.ListGroups(uint(id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "hall not found"})
+			return
+		}
+		h.logger.Error("failed to list seat groups", "hall_id", id, "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to list seat groups"})
+		return
+	}
+	c.JSON(http.StatusOK, groups)
+}
+
+func (h *SeatHandler) DeleteGroup(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	if err := h.seatService.DeleteGroup(uint(id)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "seat group not found"})
+			return
+		}
+		h.logger.Error("failed to delete seat group", "id", id, "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to delete seat group"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "seat group deleted successfully"})
+}
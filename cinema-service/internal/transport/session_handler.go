@@ -1,9 +1,12 @@
 package transport
 
 import (
+	"cinema-service/internal/constants"
 	"cinema-service/internal/dto"
+	"cinema-service/internal/ics"
 	"cinema-service/internal/services"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"strconv"
@@ -32,7 +35,14 @@ func (h *SessionHandler) RegisterRoutes(r *gin.Engine) {
 		sessions.POST("/sessions", h.Create)
 		sessions.PATCH("/sessions/:id", h.Update)
 		sessions.DELETE("/sessions/:id", h.Delete)
+		sessions.GET("/sessions/:id/seats", h.SeatMap)
 		sessions.GET("/movies/:id/sessions", h.ListByMovieID)
+		sessions.GET("/halls/:id/free-slots", h.FreeSlots)
+		sessions.GET("/schedule", h.Schedule)
+		sessions.GET("/movies/:id/sessions.ics", h.Calendar("movie"))
+		sessions.GET("/halls/:id/sessions.ics", h.Calendar("hall"))
+		sessions.GET("/cinemas/:id/sessions.ics", h.Calendar("cinema"))
+		sessions.GET("/sessions/:id/booking.ics", h.BookingCalendar)
 	}
 }
 
@@ -51,6 +61,13 @@ func (h *SessionHandler) Create(c *gin.Context) {
 			c.JSON(http.StatusNotFound, gin.H{"error": "hall not found"})
 			return
 		}
+		if errors.Is(err, constants.ErrMovieNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if respondOverlap(c, err) {
+			return
+		}
 
 		h.logger.Error("failed to create session", "err", err)
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -61,16 +78,48 @@ func (h *SessionHandler) Create(c *gin.Context) {
 }
 
 func (h *SessionHandler) List(c *gin.Context) {
-	sessions, err := h.sessionService.List()
+	var query dto.SessionSearchQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	sessions, total, err := h.sessionService.Search(query)
 	if err != nil {
+		if errors.Is(err, constants.ErrCinemaNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
 		h.logger.Error("failed to list sessions", "err", err)
-		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to list sessions"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
+	c.Header("X-Total-Count", strconv.FormatInt(total, 10))
 	c.JSON(http.StatusOK, sessions)
 }
 
+func (h *SessionHandler) Schedule(c *gin.Context) {
+	var query dto.ScheduleQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	schedule, err := h.sessionService.Schedule(query)
+	if err != nil {
+		if errors.Is(err, constants.ErrCinemaNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		h.logger.Error("failed to build schedule", "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, schedule)
+}
+
 func (h *SessionHandler) GetById(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
@@ -115,6 +164,17 @@ func (h *SessionHandler) Update(c *gin.Context) {
 			c.JSON(http.StatusNotFound, gin.H{"error": "session not found"})
 			return
 		}
+		if errors.Is(err, constants.ErrMovieNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, constants.ErrInvalidStatusTransition) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		if respondOverlap(c, err) {
+			return
+		}
 
 		h.logger.Error("failed to update session", "id", id, "err", err)
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -132,11 +192,21 @@ func (h *SessionHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	if err := h.sessionService.Delete(uint(id)); err != nil {
+	var query dto.DeleteQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.sessionService.Delete(uint(id), query.Force); err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{"error": "session not found"})
 			return
 		}
+		if errors.Is(err, constants.ErrActiveBookings) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
 
 		h.logger.Error("failed to delete session", "id", id, "err", err)
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -146,6 +216,29 @@ func (h *SessionHandler) Delete(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "session deleted successfully"})
 }
 
+func (h *SessionHandler) SeatMap(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	seats, err := h.sessionService.SeatMap(uint(id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "session not found"})
+			return
+		}
+
+		h.logger.Error("failed to build seat map", "id", id, "err", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to build seat map"})
+		return
+	}
+
+	c.JSON(http.StatusOK, seats)
+}
+
 func (h *SessionHandler) ListByMovieID(c *gin.Context) {
 	idStr := c.Param("id")
 	movieID, err := strconv.ParseUint(idStr, 10, 32)
@@ -167,3 +260,125 @@ func (h *SessionHandler) ListByMovieID(c *gin.Context) {
 
 	c.JSON(http.StatusOK, sessions)
 }
+
+func (h *SessionHandler) FreeSlots(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var query dto.FreeSlotsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	slots, err := h.sessionService.FreeSlots(uint(id), query)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "hall not found"})
+			return
+		}
+		h.logger.Error("failed to list free slots", "hall_id", id, "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, slots)
+}
+
+func respondOverlap(c *gin.Context, err error) bool {
+	var overlap *constants.SessionOverlapError
+	if !errors.As(err, &overlap) {
+		return false
+	}
+
+	c.JSON(http.StatusConflict, gin.H{
+		"error":                   constants.ErrSessionOverlap.Error(),
+		"conflicting_session_ids": overlap.ConflictingIDs,
+	})
+	return true
+}
+
+// Calendar serves the sessions of a movie, hall or venue as an iCalendar feed
+// that calendar apps can subscribe to.
+func (h *SessionHandler) Calendar(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idStr := c.Param("id")
+		id, err := strconv.ParseUint(idStr, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+			return
+		}
+
+		var query dto.CalendarQuery
+		switch scope {
+		case "movie":
+			query.MovieID = uint(id)
+		case "hall":
+			query.HallID = uint(id)
+		default:
+			query.CinemaID = uint(id)
+		}
+
+		name, events, err := h.sessionService.Calendar(query)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) ||
+				errors.Is(err, constants.ErrMovieNotFound) ||
+				errors.Is(err, constants.ErrCinemaNotFound) {
+				c.JSON(http.StatusNotFound, gin.H{"error": scope + " not found"})
+				return
+			}
+			h.logger.Error("failed to build calendar", "scope", scope, "id", id, "err", err)
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Header("Content-Type", "text/calendar; charset=utf-8")
+		c.Header("Content-Disposition", fmt.Sprintf("inline; filename=%s-%d-sessions.ics", scope, id))
+		c.Status(http.StatusOK)
+		if err := ics.Write(c.Writer, constants.CalendarProdID, name, events); err != nil {
+			h.logger.Error("failed to write calendar", "scope", scope, "id", id, "err", err)
+		}
+	}
+}
+
+// BookingCalendar serves the .ics attachment of one booking of the session.
+func (h *SessionHandler) BookingCalendar(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var query dto.BookingCalendarQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	event, err := h.sessionService.BookingCalendar(uint(id), query)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "session not found"})
+			return
+		}
+		if errors.Is(err, constants.ErrInvalidIDList) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		h.logger.Error("failed to build booking calendar", "session_id", id, "booking_id", query.BookingID, "err", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to build calendar"})
+		return
+	}
+
+	c.Header("Content-Type", "text/calendar; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=booking-%d.ics", query.BookingID))
+	c.Status(http.StatusOK)
+	if err := ics.Write(c.Writer, constants.CalendarProdID, "", []ics.Event{event}); err != nil {
+		h.logger.Error("failed to write booking calendar", "session_id", id, "booking_id", query.BookingID, "err", err)
+	}
+}
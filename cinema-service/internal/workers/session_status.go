@@ -0,0 +1,23 @@
+package workers
+
+import (
+	"cinema-service/internal/services"
+	"log/slog"
+	"time"
+)
+
+func StartSessionStatusWorker(sessionService services.SessionService, logger *slog.Logger) {
+	ticker := time.NewTicker(30 * time.Second)
+
+	logger.Info("session status worker started", "interval", "30 second")
+
+	if err := sessionService.AdvanceStatuses(time.Now()); err != nil {
+		logger.Error("failed to advance session statuses on startup", "error", err)
+	}
+
+	for range ticker.C {
+		if err := sessionService.AdvanceStatuses(time.Now()); err != nil {
+			logger.Error("failed to advance session statuses", "error", err)
+		}
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -177,6 +179,579 @@ func main() {
 		}
 		defer resp.Body.Close()
 
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		if total := resp.Header.Get("X-Total-Count"); total != "" {
+			c.Header("X-Total-Count", total)
+		}
+		c.Data(resp.StatusCode, "application/json", b)
+	})
+
+	router.GET("/api/schedule", func(c *gin.Context) {
+		req, err := http.NewRequest("GET", strings.TrimRight(cinemaSvc, "/")+"/schedule", nil)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+		req.URL.RawQuery = c.Request.URL.RawQuery
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "cinema service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		c.Data(resp.StatusCode, "application/json", b)
+	})
+
+	router.GET("/api/cinemas", func(c *gin.Context) {
+		req, err := http.NewRequest("GET", strings.TrimRight(cinemaSvc, "/")+"/cinemas", nil)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "cinema service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		c.Data(resp.StatusCode, "application/json", b)
+	})
+
+	router.GET("/api/cinemas/:id", func(c *gin.Context) {
+		id := c.Param("id")
+		req, err := http.NewRequest("GET", strings.TrimRight(cinemaSvc, "/")+"/cinemas/"+id, nil)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "cinema service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		c.Data(resp.StatusCode, "application/json", b)
+	})
+
+	router.GET("/api/movies/:id/sessions.ics", func(c *gin.Context) {
+		id := c.Param("id")
+
+		req, err := http.NewRequest("GET", strings.TrimRight(cinemaSvc, "/")+"/movies/"+id+"/sessions.ics", nil)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "cinema service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		if disposition := resp.Header.Get("Content-Disposition"); disposition != "" {
+			c.Header("Content-Disposition", disposition)
+		}
+		c.Data(resp.StatusCode, resp.Header.Get("Content-Type"), b)
+	})
+
+	router.GET("/api/halls/:id/sessions.ics", func(c *gin.Context) {
+		id := c.Param("id")
+
+		req, err := http.NewRequest("GET", strings.TrimRight(cinemaSvc, "/")+"/halls/"+id+"/sessions.ics", nil)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "cinema service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		if disposition := resp.Header.Get("Content-Disposition"); disposition != "" {
+			c.Header("Content-Disposition", disposition)
+		}
+		c.Data(resp.StatusCode, resp.Header.Get("Content-Type"), b)
+	})
+
+	router.GET("/api/cinemas/:id/sessions.ics", func(c *gin.Context) {
+		id := c.Param("id")
+
+		req, err := http.NewRequest("GET", strings.TrimRight(cinemaSvc, "/")+"/cinemas/"+id+"/sessions.ics", nil)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "cinema service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		if disposition := resp.Header.Get("Content-Disposition"); disposition != "" {
+			c.Header("Content-Disposition", disposition)
+		}
+		c.Data(resp.StatusCode, resp.Header.Get("Content-Type"), b)
+	})
+
+	router.GET("/api/sessions/:id", func(c *gin.Context) {
+		id := c.Param("id")
+		req, err := http.NewRequest("GET", strings.TrimRight(cinemaSvc, "/")+"/sessions/"+id, nil)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "cinema service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		c.Data(resp.StatusCode, "application/json", b)
+	})
+
+	router.POST("/api/sessions", func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+			return
+		}
+
+		req, err := http.NewRequest("POST", strings.TrimRight(cinemaSvc, "/")+"/sessions", bytes.NewReader(body))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "cinema service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		c.Data(resp.StatusCode, "application/json", b)
+	})
+
+	router.GET("/api/bookings", func(c *gin.Context) {
+		if !validateJWT(c) {
+			return
+		}
+
+		req, err := http.NewRequest("GET", strings.TrimRight(bookingSvc, "/")+"/bookings", nil)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+		req.URL.RawQuery = c.Request.URL.RawQuery
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "booking service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		c.Data(resp.StatusCode, "application/json", b)
+	})
+
+	router.GET("/api/bookings/:id", func(c *gin.Context) {
+		if !validateJWT(c) {
+			return
+		}
+		id := c.Param("id")
+
+		req, err := http.NewRequest("GET", strings.TrimRight(bookingSvc, "/")+"/bookings/"+id, nil)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "booking service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		c.Data(resp.StatusCode, "application/json", b)
+	})
+
+	router.POST("/api/bookings", func(c *gin.Context) {
+		userID, ok := jwtUserID(c)
+		if !ok {
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+			return
+		}
+		// The booking is always made for the caller; age and presale checks
+		// rely on this user_id.
+		body, err = setUserID(body, userID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid JSON"})
+			return
+		}
+
+		req, err := http.NewRequest("POST", strings.TrimRight(bookingSvc, "/")+"/bookings", bytes.NewReader(body))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", c.GetHeader("Authorization"))
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "booking service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		c.Data(resp.StatusCode, "application/json", b)
+	})
+
+	router.GET("/api/bookings/:id/calendar.ics", func(c *gin.Context) {
+		if !validateJWT(c) {
+			return
+		}
+
+		id := c.Param("id")
+
+		req, err := http.NewRequest("GET", strings.TrimRight(bookingSvc, "/")+"/bookings/"+id+"/calendar.ics", nil)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "booking service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		if disposition := resp.Header.Get("Content-Disposition"); disposition != "" {
+			c.Header("Content-Disposition", disposition)
+		}
+		c.Data(resp.StatusCode, resp.Header.Get("Content-Type"), b)
+	})
+
+	router.PATCH("/api/bookings/:id", func(c *gin.Context) {
+		if !validateJWT(c) {
+			return
+		}
+		id := c.Param("id")
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+			return
+		}
+
+		req, err := http.NewRequest("PATCH", strings.TrimRight(bookingSvc, "/")+"/bookings/"+id, bytes.NewReader(body))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "booking service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		c.Data(resp.StatusCode, "application/json", b)
+	})
+
+	router.DELETE("/api/bookings/:id", func(c *gin.Context) {
+		if !validateJWT(c) {
+			return
+		}
+		id := c.Param("id")
+
+		req, err := http.NewRequest("DELETE", strings.TrimRight(bookingSvc, "/")+"/bookings/"+id, nil)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "booking service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		c.Data(resp.StatusCode, "application/json", b)
+	})
+
+	router.POST("/api/bookings/:id/confirm", func(c *gin.Context) {
+		if !validateJWT(c) {
+			return
+		}
+		id := c.Param("id")
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+			return
+		}
+
+		req, err := http.NewRequest("POST", strings.TrimRight(bookingSvc, "/")+"/bookings/"+id+"/confirm", bytes.NewReader(body))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "booking service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		c.Data(resp.StatusCode, "application/json", b)
+	})
+
+	router.POST("/api/bookings/:id/cancel", func(c *gin.Context) {
+		if !validateJWT(c) {
+			return
+		}
+		id := c.Param("id")
+
+		req, err := http.NewRequest("POST", strings.TrimRight(bookingSvc, "/")+"/bookings/"+id+"/cancel", nil)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "booking service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		c.Data(resp.StatusCode, "application/json", b)
+	})
+
+	router.POST("/api/guest/bookings", func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+			return
+		}
+		body, err = setUserID(body, 0)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid JSON"})
+			return
+		}
+
+		req, err := http.NewRequest("POST", strings.TrimRight(bookingSvc, "/")+"/guest/bookings", bytes.NewReader(body))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "booking service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		c.Data(resp.StatusCode, "application/json", b)
+	})
+
+	router.POST("/api/bookings/attach-guest", func(c *gin.Context) {
+		if !validateJWT(c) {
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+			return
+		}
+
+		req, err := http.NewRequest("POST", strings.TrimRight(bookingSvc, "/")+"/bookings/attach-guest", bytes.NewReader(body))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", c.GetHeader("Authorization"))
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "booking service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		c.Data(resp.StatusCode, "application/json", b)
+	})
+
+	router.POST("/api/bookings/best-seats", func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+			return
+		}
+
+		// Holding seats for an account needs that account's token; without
+		// one the request is handled as a guest.
+		var requested struct {
+			UserID uint `json:"user_id"`
+		}
+		_ = json.Unmarshal(body, &requested)
+
+		var userID uint
+		if c.GetHeader("Authorization") != "" || requested.UserID != 0 {
+			var ok bool
+			if userID, ok = jwtUserID(c); !ok {
+				return
+			}
+		}
+		body, err = setUserID(body, userID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid JSON"})
+			return
+		}
+
+		req, err := http.NewRequest("POST", strings.TrimRight(bookingSvc, "/")+"/bookings/best-seats", bytes.NewReader(body))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if userID != 0 {
+			req.Header.Set("Authorization", c.GetHeader("Authorization"))
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "booking service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
 		b, err := io.ReadAll(resp.Body)
 		if err != nil {
 			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
@@ -185,17 +760,20 @@ func main() {
 		c.Data(resp.StatusCode, "application/json", b)
 	})
 
-	router.GET("/api/sessions/:id", func(c *gin.Context) {
+	router.GET("/api/guest/bookings/:id", func(c *gin.Context) {
 		id := c.Param("id")
-		req, err := http.NewRequest("GET", strings.TrimRight(cinemaSvc, "/")+"/sessions/"+id, nil)
+
+		req, err := http.NewRequest("GET", strings.TrimRight(bookingSvc, "/")+"/guest/bookings/"+id, nil)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
 			return
 		}
+		req.URL.RawQuery = c.Request.URL.RawQuery
+		req.Header.Set("X-Guest-Token", c.GetHeader("X-Guest-Token"))
 
 		resp, err := httpClient.Do(req)
 		if err != nil {
-			c.JSON(http.StatusBadGateway, gin.H{"error": "cinema service unavailable"})
+			c.JSON(http.StatusBadGateway, gin.H{"error": "booking service unavailable"})
 			return
 		}
 		defer resp.Body.Close()
@@ -208,23 +786,61 @@ func main() {
 		c.Data(resp.StatusCode, "application/json", b)
 	})
 
-	router.POST("/api/sessions", func(c *gin.Context) {
+	router.GET("/api/guest/bookings/:id/calendar.ics", func(c *gin.Context) {
+		id := c.Param("id")
+
+		req, err := http.NewRequest("GET", strings.TrimRight(bookingSvc, "/")+"/guest/bookings/"+id+"/calendar.ics", nil)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+		req.URL.RawQuery = c.Request.URL.RawQuery
+		req.Header.Set("X-Guest-Token", c.GetHeader("X-Guest-Token"))
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "booking service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		if disposition := resp.Header.Get("Content-Disposition"); disposition != "" {
+			c.Header("Content-Disposition", disposition)
+		}
+		c.Data(resp.StatusCode, resp.Header.Get("Content-Type"), b)
+	})
+
+	router.POST("/api/guest/bookings/:id/:action", func(c *gin.Context) {
+		id := c.Param("id")
+		action := c.Param("action")
+		if action != "confirm" && action != "cancel" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "not found"})
+			return
+		}
+
 		body, err := io.ReadAll(c.Request.Body)
 		if err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
 			return
 		}
 
-		req, err := http.NewRequest("POST", strings.TrimRight(cinemaSvc, "/")+"/sessions", bytes.NewReader(body))
+		req, err := http.NewRequest("POST", strings.TrimRight(bookingSvc, "/")+"/guest/bookings/"+id+"/"+action, bytes.NewReader(body))
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
 			return
 		}
+		req.URL.RawQuery = c.Request.URL.RawQuery
 		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Guest-Token", c.GetHeader("X-Guest-Token"))
 
 		resp, err := httpClient.Do(req)
 		if err != nil {
-			c.JSON(http.StatusBadGateway, gin.H{"error": "cinema service unavailable"})
+			c.JSON(http.StatusBadGateway, gin.H{"error": "booking service unavailable"})
 			return
 		}
 		defer resp.Body.Close()
@@ -237,17 +853,13 @@ func main() {
 		c.Data(resp.StatusCode, "application/json", b)
 	})
 
-	router.GET("/api/bookings", func(c *gin.Context) {
-		if !validateJWT(c) {
-			return
-		}
-
-		req, err := http.NewRequest("GET", strings.TrimRight(bookingSvc, "/")+"/bookings", nil)
+	router.GET("/api/products", func(c *gin.Context) {
+		req, err := http.NewRequest("GET", strings.TrimRight(bookingSvc, "/")+"/products", nil)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
 			return
 		}
-		req.URL.RawQuery = c.Request.URL.RawQuery
+		req.URL.RawQuery = "active=true"
 
 		resp, err := httpClient.Do(req)
 		if err != nil {
@@ -264,17 +876,24 @@ func main() {
 		c.Data(resp.StatusCode, "application/json", b)
 	})
 
-	router.GET("/api/bookings/:id", func(c *gin.Context) {
+	router.POST("/api/vouchers/purchase", func(c *gin.Context) {
 		if !validateJWT(c) {
 			return
 		}
-		id := c.Param("id")
 
-		req, err := http.NewRequest("GET", strings.TrimRight(bookingSvc, "/")+"/bookings/"+id, nil)
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+			return
+		}
+
+		req, err := http.NewRequest("POST", strings.TrimRight(bookingSvc, "/")+"/vouchers/purchase", bytes.NewReader(body))
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
 			return
 		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", c.GetHeader("Authorization"))
 
 		resp, err := httpClient.Do(req)
 		if err != nil {
@@ -291,23 +910,41 @@ func main() {
 		c.Data(resp.StatusCode, "application/json", b)
 	})
 
-	router.POST("/api/bookings", func(c *gin.Context) {
+	router.GET("/api/vouchers/purchased", func(c *gin.Context) {
 		if !validateJWT(c) {
 			return
 		}
 
-		body, err := io.ReadAll(c.Request.Body)
+		req, err := http.NewRequest("GET", strings.TrimRight(bookingSvc, "/")+"/vouchers/purchased", nil)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
 			return
 		}
+		req.Header.Set("Authorization", c.GetHeader("Authorization"))
 
-		req, err := http.NewRequest("POST", strings.TrimRight(bookingSvc, "/")+"/bookings", bytes.NewReader(body))
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "booking service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		c.Data(resp.StatusCode, "application/json", b)
+	})
+
+	router.GET("/api/vouchers/:code", func(c *gin.Context) {
+		code := c.Param("code")
+
+		req, err := http.NewRequest("GET", strings.TrimRight(bookingSvc, "/")+"/vouchers/"+url.PathEscape(code)+"/balance", nil)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
 			return
 		}
-		req.Header.Set("Content-Type", "application/json")
 
 		resp, err := httpClient.Do(req)
 		if err != nil {
@@ -324,11 +961,10 @@ func main() {
 		c.Data(resp.StatusCode, "application/json", b)
 	})
 
-	router.PATCH("/api/bookings/:id", func(c *gin.Context) {
+	router.PATCH("/api/users/me", func(c *gin.Context) {
 		if !validateJWT(c) {
 			return
 		}
-		id := c.Param("id")
 
 		body, err := io.ReadAll(c.Request.Body)
 		if err != nil {
@@ -336,16 +972,17 @@ func main() {
 			return
 		}
 
-		req, err := http.NewRequest("PATCH", strings.TrimRight(bookingSvc, "/")+"/bookings/"+id, bytes.NewReader(body))
+		req, err := http.NewRequest("PATCH", strings.TrimRight(userSvc, "/")+"/users/me", bytes.NewReader(body))
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
 			return
 		}
 		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", c.GetHeader("Authorization"))
 
 		resp, err := httpClient.Do(req)
 		if err != nil {
-			c.JSON(http.StatusBadGateway, gin.H{"error": "booking service unavailable"})
+			c.JSON(http.StatusBadGateway, gin.H{"error": "user service unavailable"})
 			return
 		}
 		defer resp.Body.Close()
@@ -358,21 +995,21 @@ func main() {
 		c.Data(resp.StatusCode, "application/json", b)
 	})
 
-	router.DELETE("/api/bookings/:id", func(c *gin.Context) {
+	router.GET("/api/users/me/notification-preferences", func(c *gin.Context) {
 		if !validateJWT(c) {
 			return
 		}
-		id := c.Param("id")
 
-		req, err := http.NewRequest("DELETE", strings.TrimRight(bookingSvc, "/")+"/bookings/"+id, nil)
+		req, err := http.NewRequest("GET", strings.TrimRight(userSvc, "/")+"/me/notification-preferences", nil)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
 			return
 		}
+		req.Header.Set("Authorization", c.GetHeader("Authorization"))
 
 		resp, err := httpClient.Do(req)
 		if err != nil {
-			c.JSON(http.StatusBadGateway, gin.H{"error": "booking service unavailable"})
+			c.JSON(http.StatusBadGateway, gin.H{"error": "user service unavailable"})
 			return
 		}
 		defer resp.Body.Close()
@@ -385,21 +1022,28 @@ func main() {
 		c.Data(resp.StatusCode, "application/json", b)
 	})
 
-	router.POST("/api/bookings/:id/confirm", func(c *gin.Context) {
+	router.PUT("/api/users/me/notification-preferences", func(c *gin.Context) {
 		if !validateJWT(c) {
 			return
 		}
-		id := c.Param("id")
 
-		req, err := http.NewRequest("POST", strings.TrimRight(bookingSvc, "/")+"/bookings/"+id+"/confirm", nil)
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+			return
+		}
+
+		req, err := http.NewRequest("PUT", strings.TrimRight(userSvc, "/")+"/me/notification-preferences", bytes.NewReader(body))
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
 			return
 		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", c.GetHeader("Authorization"))
 
 		resp, err := httpClient.Do(req)
 		if err != nil {
-			c.JSON(http.StatusBadGateway, gin.H{"error": "booking service unavailable"})
+			c.JSON(http.StatusBadGateway, gin.H{"error": "user service unavailable"})
 			return
 		}
 		defer resp.Body.Close()
@@ -412,17 +1056,24 @@ func main() {
 		c.Data(resp.StatusCode, "application/json", b)
 	})
 
-	router.POST("/api/bookings/:id/cancel", func(c *gin.Context) {
-		if !validateJWT(c) {
+	router.POST("/api/vouchers", func(c *gin.Context) {
+		if !validateAdmin(c) {
 			return
 		}
-		id := c.Param("id")
 
-		req, err := http.NewRequest("POST", strings.TrimRight(bookingSvc, "/")+"/bookings/"+id+"/cancel", nil)
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+			return
+		}
+
+		req, err := http.NewRequest("POST", strings.TrimRight(bookingSvc, "/")+"/vouchers", bytes.NewReader(body))
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
 			return
 		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", c.GetHeader("Authorization"))
 
 		resp, err := httpClient.Do(req)
 		if err != nil {
@@ -439,6 +1090,38 @@ func main() {
 		c.Data(resp.StatusCode, "application/json", b)
 	})
 
+	router.GET("/api/reports/:report", func(c *gin.Context) {
+		if !validateAdmin(c) {
+			return
+		}
+		report := c.Param("report")
+
+		req, err := http.NewRequest("GET", strings.TrimRight(bookingSvc, "/")+"/reports/"+report, nil)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+		req.URL.RawQuery = c.Request.URL.RawQuery
+		req.Header.Set("Authorization", c.GetHeader("Authorization"))
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "booking service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+			return
+		}
+		if disposition := resp.Header.Get("Content-Disposition"); disposition != "" {
+			c.Header("Content-Disposition", disposition)
+		}
+		c.Data(resp.StatusCode, resp.Header.Get("Content-Type"), b)
+	})
+
 	router.GET("/api/sessions/:id/aggregate", func(c *gin.Context) {
 		id := c.Param("id")
 
@@ -501,13 +1184,157 @@ func main() {
 			}
 		}
 
-		c.JSON(http.StatusOK, gin.H{"session": session, "movie": movie, "hall": hall})
+		cinemaID := toIDString(hall["cinema_id"])
+		var cinema map[string]interface{}
+		if cinemaID != "" {
+			req4, err := http.NewRequest("GET", strings.TrimRight(cinemaSvc, "/")+"/cinemas/"+cinemaID, nil)
+			if err == nil {
+				r4, err := httpClient.Do(req4)
+				if err == nil && r4 != nil {
+					defer r4.Body.Close()
+					if r4.StatusCode < 400 {
+						_ = json.NewDecoder(r4.Body).Decode(&cinema)
+						delete(cinema, "halls")
+					}
+				}
+			}
+		}
+
+		c.JSON(http.StatusOK, gin.H{"session": session, "movie": movie, "hall": hall, "cinema": cinema})
+	})
+
+	router.GET("/api/sessions/aggregate", func(c *gin.Context) {
+		ids := c.Query("ids")
+		if ids == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ids is required"})
+			return
+		}
+
+		req, err := http.NewRequest("GET", strings.TrimRight(cinemaSvc, "/")+"/sessions", nil)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create request"})
+			return
+		}
+		req.URL.RawQuery = url.Values{"ids": {ids}}.Encode()
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "cinema service unavailable"})
+			return
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode >= 400 {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				c.JSON(http.StatusBadGateway, gin.H{"error": "failed to read response"})
+				return
+			}
+			c.Data(resp.StatusCode, "application/json", b)
+			return
+		}
+
+		var sessions []map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&sessions); err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to decode sessions"})
+			return
+		}
+
+		var movieIDs, hallIDs []string
+		for _, session := range sessions {
+			if id := toIDString(session["movie_id"]); id != "" && !slices.Contains(movieIDs, id) {
+				movieIDs = append(movieIDs, id)
+			}
+			if id := toIDString(session["hall_id"]); id != "" && !slices.Contains(hallIDs, id) {
+				hallIDs = append(hallIDs, id)
+			}
+		}
+
+		movies := fetchByID(httpClient, strings.TrimRight(movieSvc, "/")+"/movies/", movieIDs)
+		halls := fetchByID(httpClient, strings.TrimRight(cinemaSvc, "/")+"/halls", hallIDs)
+
+		var cinemaIDs []string
+		for _, hall := range halls {
+			if id := toIDString(hall["cinema_id"]); id != "" && !slices.Contains(cinemaIDs, id) {
+				cinemaIDs = append(cinemaIDs, id)
+			}
+		}
+		cinemas := make(map[string]map[string]interface{})
+		if len(cinemaIDs) > 0 {
+			for id, cinema := range fetchByID(httpClient, strings.TrimRight(cinemaSvc, "/")+"/cinemas", nil) {
+				if slices.Contains(cinemaIDs, id) {
+					delete(cinema, "halls")
+					cinemas[id] = cinema
+				}
+			}
+		}
+
+		items := make([]gin.H, 0, len(sessions))
+		for _, session := range sessions {
+			hall := halls[toIDString(session["hall_id"])]
+			items = append(items, gin.H{
+				"session": session,
+				"movie":   movies[toIDString(session["movie_id"])],
+				"hall":    hall,
+				"cinema":  cinemas[toIDString(hall["cinema_id"])],
+			})
+		}
+
+		c.JSON(http.StatusOK, items)
 	})
 
 	port := getEnv("PORT", "8085")
 	router.Run(":" + port)
 }
 
+// maxBatchIDs is the most ids the services accept in one ?ids= lookup.
+const maxBatchIDs = 100
+
+// fetchByID loads a list endpoint, filtered with ?ids= when ids are given,
+// and indexes the result by id. The ids are sent in chunks of maxBatchIDs.
+// Lookup failures leave the affected items out, like the single-session
+// aggregate leaves missing parts null.
+func fetchByID(client *http.Client, endpoint string, ids []string) map[string]map[string]interface{} {
+	result := make(map[string]map[string]interface{})
+
+	if len(ids) == 0 {
+		fetchInto(client, endpoint, nil, result)
+		return result
+	}
+	for chunk := range slices.Chunk(ids, maxBatchIDs) {
+		fetchInto(client, endpoint, chunk, result)
+	}
+	return result
+}
+
+func fetchInto(client *http.Client, endpoint string, ids []string, result map[string]map[string]interface{}) {
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return
+	}
+	if len(ids) > 0 {
+		req.URL.RawQuery = url.Values{"ids": {strings.Join(ids, ",")}}.Encode()
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return
+	}
+
+	var items []map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&items); err != nil {
+		return
+	}
+	for _, item := range items {
+		result[toIDString(item["id"])] = item
+	}
+}
+
 func getEnv(key, def string) string {
 	v := os.Getenv(key)
 	if v == "" {
@@ -519,7 +1346,7 @@ func getEnv(key, def string) string {
 func toIDString(v interface{}) string {
 	switch t := v.(type) {
 	case float64:
-		return strings.TrimRight(strings.TrimRight(fmtFloat(t), ".0"), ".")
+		return fmtFloat(t)
 	case string:
 		return t
 	default:
@@ -551,3 +1378,75 @@ func validateJWT(c *gin.Context) bool {
 	}
 	return true
 }
+
+// jwtUserID validates the bearer token like validateJWT and returns the user
+// it was issued to.
+func jwtUserID(c *gin.Context) (uint, bool) {
+	secret := []byte(os.Getenv("JWT_SECRET"))
+	auth := c.GetHeader("Authorization")
+	if auth == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "missing authorization header"})
+		return 0, false
+	}
+	parts := strings.SplitN(auth, " ", 2)
+	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid authorization header"})
+		return 0, false
+	}
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(parts[1], claims, func(t *jwt.Token) (interface{}, error) { return secret, nil })
+	if err != nil || !token.Valid {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid token"})
+		return 0, false
+	}
+	userID, _ := claims["user_id"].(float64)
+	if userID < 1 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid token claims"})
+		return 0, false
+	}
+	return uint(userID), true
+}
+
+// setUserID replaces the user_id of a JSON request body with the caller's
+// identity, so clients cannot act for another user. Zero removes the field.
+func setUserID(body []byte, userID uint) ([]byte, error) {
+	var payload map[string]interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	if payload == nil {
+		payload = map[string]interface{}{}
+	}
+
+	if userID == 0 {
+		delete(payload, "user_id")
+	} else {
+		payload["user_id"] = userID
+	}
+	return json.Marshal(payload)
+}
+
+func validateAdmin(c *gin.Context) bool {
+	secret := []byte(os.Getenv("JWT_SECRET"))
+	auth := c.GetHeader("Authorization")
+	if auth == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "missing authorization header"})
+		return false
+	}
+	parts := strings.SplitN(auth, " ", 2)
+	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid authorization header"})
+		return false
+	}
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(parts[1], claims, func(t *jwt.Token) (interface{}, error) { return secret, nil })
+	if err != nil || !token.Valid {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid token"})
+		return false
+	}
+	if role, _ := claims["role"].(string); role != "admin" {
+		c.JSON(http.StatusForbidden, gin.H{"error": "admin access required"})
+		return false
+	}
+	return true
+}
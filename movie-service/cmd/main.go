@@ -36,6 +36,10 @@ func main() {
 	movieService := services.NewMovieService(movieRepo, genreRepo, logger)
 	genreService := services.NewGenreService(genreRepo, logger)
 
+	if err := movieService.NormalizeAgeRatings(); err != nil {
+		logger.Error("failed to normalize age ratings", slog.Any("error", err))
+	}
+
 	transport.RegisterRoutes(r, movieService, genreService, logger)
 
 	port := os.Getenv("PORT")
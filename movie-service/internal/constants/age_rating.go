@@ -0,0 +1,41 @@
+package constants
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var letterAgeRatings = map[string]uint{
+	"G":     0,
+	"PG":    0,
+	"R":     17,
+	"NC-17": 17,
+	"X":     18,
+}
+
+// MinAgeFromRating normalizes a free-text age rating into the minimum viewer
+// age. Numeric ratings ("16+", "PG-13") use the number, letter-only MPAA
+// ratings use their conventional ages and unknown ratings are unrestricted.
+func MinAgeFromRating(rating string) uint {
+	rating = strings.ToUpper(strings.TrimSpace(rating))
+
+	if age, ok := letterAgeRatings[rating]; ok {
+		return age
+	}
+
+	start := strings.IndexFunc(rating, unicode.IsDigit)
+	if start < 0 {
+		return 0
+	}
+	end := start
+	for end < len(rating) && unicode.IsDigit(rune(rating[end])) {
+		end++
+	}
+
+	age, err := strconv.ParseUint(rating[start:end], 10, 32)
+	if err != nil {
+		return 0
+	}
+	return uint(age)
+}
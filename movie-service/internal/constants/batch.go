@@ -0,0 +1,5 @@
+package constants
+
+// MaxBatchIDs caps the ids accepted by the ?ids= batch lookup, matching the
+// cinema-service batch lookups.
+const MaxBatchIDs = 100
@@ -9,6 +9,7 @@ type Movie struct {
 	Year        uint                  `json:"year" gorm:"not null;index"`
 	Duration    uint                  `json:"duration" gorm:"not null"`
 	AgeRating   string                `json:"age_rating" gorm:"type:varchar(50);not null"`
+	MinAge      uint                  `json:"min_age" gorm:"not null;default:0"`
 	MovieStatus constants.MovieStatus `json:"movie_status" gorm:"type:varchar(50);not null"`
 	Genres      []Genre               `json:"genres" gorm:"many2many:movie_genres;"`
 }
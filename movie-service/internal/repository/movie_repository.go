@@ -13,6 +13,8 @@ type MovieRepository interface {
 
 	List() ([]models.Movie, error)
 
+	ListByIDs(ids []uint) ([]models.Movie, error)
+
 	GetByID(id uint) (*models.Movie, error)
 
 	GetNowShowing() ([]models.Movie, error)
@@ -21,6 +23,8 @@ type MovieRepository interface {
 
 	Update(movie *models.Movie) error
 
+	UpdateMinAge(id uint, minAge uint) error
+
 	Delete(id uint) error
 }
 
@@ -58,6 +62,18 @@ func (r *gormMovieRepository) List() ([]models.Movie, error) {
 
 }
 
+func (r *gormMovieRepository) ListByIDs(ids []uint) ([]models.Movie, error) {
+
+	var movies []models.Movie
+
+	if err := r.DB.Preload("Genres").Where("id IN ?", ids).Order("id").Find(&movies).Error; err != nil {
+		r.logger.Error("failed to list movies by ids", slog.Any("ids", ids), slog.Any("error", err))
+		return nil, err
+	}
+
+	return movies, nil
+}
+
 func (r *gormMovieRepository) GetByID(id uint) (*models.Movie, error) {
 
 	var movie models.Movie
@@ -123,3 +139,11 @@ func (r *gormMovieRepository) Delete(id uint) error {
 
 	return nil
 }
+
+func (r *gormMovieRepository) UpdateMinAge(id uint, minAge uint) error {
+	if err := r.DB.Model(&models.Movie{}).Where("id = ?", id).UpdateColumn("min_age", minAge).Error; err != nil {
+		r.logger.Error("failed to update movie min age", slog.Any("id", id), slog.Any("error", err))
+		return err
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"log/slog"
+	"movie-service/internal/constants"
 	"movie-service/internal/dto"
 	"movie-service/internal/models"
 	"movie-service/internal/repository"
@@ -12,6 +13,8 @@ type MovieService interface {
 
 	List() ([]models.Movie, error)
 
+	ListByIDs(ids []uint) ([]models.Movie, error)
+
 	GetByID(id uint) (*models.Movie, error)
 
 	GetNowShowing() ([]models.Movie, error)
@@ -21,6 +24,8 @@ type MovieService interface {
 	Update(id uint, req *dto.MovieUpdateRequest) (*models.Movie, error)
 
 	Delete(id uint) error
+
+	NormalizeAgeRatings() error
 }
 
 type movieService struct {
@@ -56,6 +61,7 @@ func (s *movieService) Create(req *dto.MovieCreateRequest) (*models.Movie, error
 		Year:        req.Year,
 		Duration:    req.Duration,
 		AgeRating:   req.AgeRating,
+		MinAge:      constants.MinAgeFromRating(req.AgeRating),
 		MovieStatus: req.MovieStatus,
 		Genres:      genres,
 	}
@@ -80,6 +86,24 @@ func (s *movieService) List() ([]models.Movie, error) {
 	return movies, nil
 }
 
+// ListByIDs returns the movies that exist among ids; unknown ids are skipped
+// so callers can enrich their lists with a single request.
+func (s *movieService) ListByIDs(ids []uint) ([]models.Movie, error) {
+
+	if len(ids) == 0 {
+		return []models.Movie{}, nil
+	}
+
+	movies, err := s.repo.ListByIDs(ids)
+
+	if err != nil {
+		s.logger.Error("movie list by ids failed", slog.Any("ids", ids), slog.Any("error", err))
+		return nil, err
+	}
+
+	return movies, nil
+}
+
 func (s *movieService) GetByID(id uint) (*models.Movie, error) {
 
 	movie, err := s.repo.GetByID(id)
@@ -149,6 +173,7 @@ func (s *movieService) Update(id uint, req *dto.MovieUpdateRequest) (*models.Mov
 
 	if req.AgeRating != nil {
 		movie.AgeRating = *req.AgeRating
+		movie.MinAge = constants.MinAgeFromRating(movie.AgeRating)
 	}
 
 	if req.MovieStatus != nil {
@@ -173,3 +198,24 @@ func (s *movieService) Delete(id uint) error {
 
 	return nil
 }
+
+func (s *movieService) NormalizeAgeRatings() error {
+
+	movies, err := s.repo.List()
+	if err != nil {
+		s.logger.Error("age rating normalization failed: list", slog.Any("error", err))
+		return err
+	}
+
+	for _, movie := range movies {
+		minAge := constants.MinAgeFromRating(movie.AgeRating)
+		if movie.MinAge == minAge {
+			continue
+		}
+		if err := s.repo.UpdateMinAge(movie.ID, minAge); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,11 +2,14 @@ package transport
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
+	"movie-service/internal/constants"
 	"movie-service/internal/dto"
 	"movie-service/internal/services"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"gorm.io/gorm"
@@ -61,6 +64,11 @@ func (h *MovieHandler) Create(ctx *gin.Context) {
 
 func (h *MovieHandler) List(ctx *gin.Context) {
 
+	if ids := ctx.Query("ids"); ids != "" {
+		h.listByIDs(ctx, ids)
+		return
+	}
+
 	movies, err := h.service.List()
 
 	if err != nil {
@@ -71,6 +79,35 @@ func (h *MovieHandler) List(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, movies)
 }
 
+func (h *MovieHandler) listByIDs(ctx *gin.Context, value string) {
+
+	parts := strings.Split(value, ",")
+	if len(parts) > constants.MaxBatchIDs {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("at most %d movie ids are allowed", constants.MaxBatchIDs)})
+		return
+	}
+	ids := make([]uint, 0, len(parts))
+
+	for _, part := range parts {
+		id, err := strconv.ParseUint(strings.TrimSpace(part), 10, 64)
+		if err != nil {
+			h.logger.Error("invalid movie ids param", slog.String("param", value), slog.Any("error", err))
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid movie ids"})
+			return
+		}
+		ids = append(ids, uint(id))
+	}
+
+	movies, err := h.service.ListByIDs(ids)
+
+	if err != nil {
+		h.logger.Error("movie list by ids handler failed", slog.Any("error", err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "movie list error"})
+		return
+	}
+	ctx.JSON(http.StatusOK, movies)
+}
+
 func (h *MovieHandler) GetByID(ctx *gin.Context) {
 
 	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"notification-service/internal/config"
+	"notification-service/internal/consumers"
+	"notification-service/internal/mailer"
+	"notification-service/internal/models"
+	"notification-service/internal/repository"
+	"notification-service/internal/services"
+	"notification-service/internal/transport"
+	"notification-service/internal/workers"
+	"os"
+
+	"github.com/gin-gonic/gin"
+)
+
+func main() {
+	logger := config.InitLogger()
+	logger.Info("Starting notification-service")
+
+	db := config.Connect()
+
+	if db == nil {
+		logger.Error("Database connection failed: database is nil")
+		return
+	}
+
+	logger.Info("Database connected successfully")
+
+	if err := db.AutoMigrate(&models.Notification{}, &models.Contact{}); err != nil {
+		logger.Error("Failed to migrate database", "error", err)
+		os.Exit(1)
+	}
+
+	logger.Info("Database migration completed")
+
+	notificationRepo := repository.NewNotificationRepository(db)
+	contactRepo := repository.NewContactRepository(db)
+	notificationService := services.NewNotificationService(notificationRepo, contactRepo, mailer.NewSMTPMailer())
+
+	ctx := context.Background()
+
+	go consumers.StartUserCreatedConsumer(ctx, notificationService)
+	go consumers.StartBookingsConsumer(ctx, notificationService)
+	go consumers.StartSessionsConsumer(ctx, notificationService)
+	go workers.StartDeliveryWorker(notificationService)
+
+	router := gin.Default()
+
+	transport.RegisterRoutes(router, notificationService)
+
+	port := os.Getenv("PORT")
+	if port == "" {
+		port = "8084"
+	}
+
+	logger.Info("Server starting", "port", port)
+
+	if err := router.Run(":" + port); err != nil {
+		logger.Error("Failed to start server", "error", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,49 @@
+package clients
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"notification-service/internal/dto"
+	"os"
+	"time"
+)
+
+var httpClient = &http.Client{
+	Timeout: 5 * time.Second,
+}
+
+func getBookingServiceURL() string {
+	url := os.Getenv("BOOKING_SERVICE_URL")
+	if url == "" {
+		return "http://localhost:8082"
+	}
+	return url
+}
+
+type activeBookingsResponse struct {
+	Bookings []dto.ActiveBooking `json:"bookings"`
+}
+
+// ListActiveBookings returns the pending and confirmed bookings of a session.
+func ListActiveBookings(sessionID uint) ([]dto.ActiveBooking, error) {
+	url := fmt.Sprintf("%s/bookings/active?session_ids=%d", getBookingServiceURL(), sessionID)
+
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("booking service returned status %d for session %d", resp.StatusCode, sessionID)
+	}
+
+	var active activeBookingsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&active); err != nil {
+		return nil, err
+	}
+
+	return active.Bookings, nil
+}
@@ -0,0 +1,8 @@
+package constants
+
+import "errors"
+
+var ErrNotificationNotFound = errors.New("notification not found")
+var ErrRecipientUnknown = errors.New("recipient email is unknown")
+var ErrTemplateNotFound = errors.New("no template for event")
+var ErrInvalidID = errors.New("invalid id")
@@ -0,0 +1,26 @@
+package constants
+
+type NotificationStatus string
+
+const (
+	NotificationPending NotificationStatus = "pending"
+	NotificationSent    NotificationStatus = "sent"
+	NotificationFailed  NotificationStatus = "failed"
+)
+
+const (
+	DefaultMaxAttempts  = 5
+	RetryBackoffSeconds = 30
+	DeliveryBatchSize   = 50
+	ClaimLeaseSeconds   = 120
+	BookingsTopic       = "bookings"
+	UserCreatedTopic    = "user.created"
+	SessionsTopic       = "sessions"
+	ConsumerGroupID     = "notification-service"
+)
+
+const (
+	EventSessionStatusChanged = "session.status_changed"
+	EventSessionCancelled     = "session.cancelled"
+	SessionStatusCancelled    = "cancelled"
+)
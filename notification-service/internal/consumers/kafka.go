@@ -0,0 +1,116 @@
+package consumers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"notification-service/internal/config"
+	"notification-service/internal/constants"
+	"notification-service/internal/dto"
+	"notification-service/internal/services"
+	"os"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	retryDelay    = 5 * time.Second
+	maxRetryDelay = 5 * time.Minute
+)
+
+var errMalformedMessage = errors.New("malformed message")
+
+func getKafkaBroker() string {
+	broker := os.Getenv("KAFKA_BROKER")
+	if broker == "" {
+		config.GetLogger().Warn("KAFKA_BROKER not set, using default localhost:9092")
+		return "localhost:9092"
+	}
+	return broker
+}
+
+func newReader(topic string) *kafka.Reader {
+	return kafka.NewReader(kafka.ReaderConfig{
+		Brokers: []string{getKafkaBroker()},
+		Topic:   topic,
+		GroupID: constants.ConsumerGroupID,
+	})
+}
+
+func StartBookingsConsumer(ctx context.Context, service services.NotificationService) {
+	consume(ctx, constants.BookingsTopic, func(value []byte) error {
+		var event dto.BookingEvent
+		if err := json.Unmarshal(value, &event); err != nil {
+			return fmt.Errorf("%w: %v", errMalformedMessage, err)
+		}
+		return service.HandleBookingEvent(event)
+	})
+}
+
+func StartUserCreatedConsumer(ctx context.Context, service services.NotificationService) {
+	consume(ctx, constants.UserCreatedTopic, func(value []byte) error {
+		var event dto.UserCreatedEvent
+		if err := json.Unmarshal(value, &event); err != nil {
+			return fmt.Errorf("%w: %v", errMalformedMessage, err)
+		}
+		return service.HandleUserCreated(event)
+	})
+}
+
+func StartSessionsConsumer(ctx context.Context, service services.NotificationService) {
+	consume(ctx, constants.SessionsTopic, func(value []byte) error {
+		var event dto.SessionStatusEvent
+		if err := json.Unmarshal(value, &event); err != nil {
+			return fmt.Errorf("%w: %v", errMalformedMessage, err)
+		}
+		return service.HandleSessionStatusChanged(event)
+	})
+}
+
+// consume commits a message only after it was handled. Failed messages are
+// retried with a growing delay; malformed ones are logged and skipped because
+// they can never be handled.
+func consume(ctx context.Context, topic string, handle func(value []byte) error) {
+	logger := config.GetLogger()
+	reader := newReader(topic)
+	defer reader.Close()
+
+	logger.Info("Kafka consumer started", "topic", topic)
+
+	for {
+		msg, err := reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				logger.Info("Kafka consumer stopped", "topic", topic)
+				return
+			}
+			logger.Error("Failed to read Kafka message", "error", err, "topic", topic)
+			continue
+		}
+
+		for delay := retryDelay; ; delay = min(delay*2, maxRetryDelay) {
+			err := handle(msg.Value)
+			if err == nil {
+				break
+			}
+			if errors.Is(err, errMalformedMessage) {
+				logger.Error("Skipping malformed Kafka message", "error", err, "topic", topic, "offset", msg.Offset)
+				break
+			}
+			logger.Error("Failed to handle Kafka message, retrying", "error", err, "topic", topic, "offset", msg.Offset, "retry_in", delay)
+
+			select {
+			case <-ctx.Done():
+				logger.Info("Kafka consumer stopped", "topic", topic)
+				return
+			case <-time.After(delay):
+			}
+		}
+
+		if err := reader.CommitMessages(ctx, msg); err != nil {
+			logger.Error("Failed to commit Kafka message", "error", err, "topic", topic, "offset", msg.Offset)
+		}
+	}
+}
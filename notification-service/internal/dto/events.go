@@ -0,0 +1,43 @@
+package dto
+
+import "time"
+
+type BookingEvent struct {
+	Event            string    `json:"event"`
+	BookingID        uint      `json:"booking_id"`
+	SessionID        uint      `json:"session_id"`
+	UserID           uint      `json:"user_id"`
+	GuestEmail       string    `json:"guest_email"`
+	BookingStatus    string    `json:"booking_status"`
+	SeatsCount       int       `json:"seats_count"`
+	TotalPrice       int       `json:"total_price"`
+	ExpiresAt        time.Time `json:"expires_at"`
+	SessionStartTime time.Time `json:"session_start_time"`
+}
+
+// SessionStatusEvent is published by cinema-service on the sessions topic.
+type SessionStatusEvent struct {
+	Event     string    `json:"event"`
+	SessionID uint      `json:"session_id"`
+	OldStatus string    `json:"old_status"`
+	NewStatus string    `json:"new_status"`
+	StartTime time.Time `json:"start_time"`
+}
+
+type ActiveBooking struct {
+	ID         uint   `json:"id"`
+	SessionID  uint   `json:"session_id"`
+	UserID     uint   `json:"user_id"`
+	GuestEmail string `json:"guest_email"`
+	SeatsCount int    `json:"seats_count"`
+}
+
+type UserCreatedEvent struct {
+	ID    uint   `json:"id"`
+	Email string `json:"email"`
+}
+
+type NotificationListQuery struct {
+	Status string `form:"status"`
+	Event  string `form:"event"`
+}
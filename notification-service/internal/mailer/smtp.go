@@ -0,0 +1,61 @@
+package mailer
+
+import (
+	"fmt"
+	"net"
+	"net/smtp"
+	"os"
+	"strings"
+)
+
+type Mailer interface {
+	Send(to, subject, body string) error
+}
+
+type smtpMailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+func NewSMTPMailer() Mailer {
+	host := getEnv("SMTP_HOST", "localhost")
+	port := getEnv("SMTP_PORT", "1025")
+
+	var auth smtp.Auth
+	if user := os.Getenv("SMTP_USER"); user != "" {
+		auth = smtp.PlainAuth("", user, os.Getenv("SMTP_PASSWORD"), host)
+	}
+
+	return &smtpMailer{
+		addr: net.JoinHostPort(host, port),
+		from: getEnv("SMTP_FROM", "no-reply@cinema-hall.local"),
+		auth: auth,
+	}
+}
+
+func (m *smtpMailer) Send(to, subject, body string) error {
+	msg := strings.Join([]string{
+		"From: " + m.from,
+		"To: " + to,
+		"Subject: " + subject,
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		body,
+	}, "\r\n")
+
+	if err := smtp.SendMail(m.addr, m.auth, m.from, []string{to}, []byte(msg)); err != nil {
+		return fmt.Errorf("smtp send to %s: %w", to, err)
+	}
+
+	return nil
+}
+
+func getEnv(key, def string) string {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	return v
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"notification-service/internal/constants"
+	"time"
+)
+
+type Notification struct {
+	Base
+
+	Event         string                       `json:"event" gorm:"not null;index"`
+	BookingID     uint                         `json:"booking_id" gorm:"index"`
+	UserID        uint                         `json:"user_id" gorm:"index"`
+	Recipient     string                       `json:"recipient"`
+	Subject       string                       `json:"subject"`
+	Body          string                       `json:"body" gorm:"type:text"`
+	Status        constants.NotificationStatus `json:"status" gorm:"default:pending;index"`
+	Attempts      int                          `json:"attempts" gorm:"not null;default:0"`
+	LastError     string                       `json:"last_error,omitempty"`
+	NextAttemptAt time.Time                    `json:"next_attempt_at" gorm:"index"`
+	SentAt        *time.Time                   `json:"sent_at,omitempty"`
+}
+
+type Contact struct {
+	Base
+
+	UserID uint   `json:"user_id" gorm:"not null;uniqueIndex"`
+	Email  string `json:"email" gorm:"not null"`
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"errors"
+	"notification-service/internal/config"
+	"notification-service/internal/constants"
+	"notification-service/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ContactRepository interface {
+	Upsert(contact *models.Contact) error
+	GetByUserID(userID uint) (*models.Contact, error)
+}
+
+type gormContactRepository struct {
+	db *gorm.DB
+}
+
+func NewContactRepository(db *gorm.DB) ContactRepository {
+	return &gormContactRepository{
+		db: db,
+	}
+}
+
+func (r *gormContactRepository) Upsert(contact *models.Contact) error {
+	err := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"email", "updated_at"}),
+	}).Create(contact).Error
+
+	if err != nil {
+		config.GetLogger().Error("Failed to upsert contact", "error", err, "user_id", contact.UserID)
+		return err
+	}
+
+	return nil
+}
+
+func (r *gormContactRepository) GetByUserID(userID uint) (*models.Contact, error) {
+	var contact models.Contact
+
+	if err := r.db.Where("user_id = ?", userID).First(&contact).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, constants.ErrRecipientUnknown
+		}
+		config.GetLogger().Error("Failed to get contact by user_id", "error", err, "user_id", userID)
+		return nil, err
+	}
+
+	return &contact, nil
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"errors"
+	"notification-service/internal/config"
+	"notification-service/internal/constants"
+	"notification-service/internal/dto"
+	"notification-service/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type NotificationRepository interface {
+	Create(notification *models.Notification) error
+	GetByID(id uint) (*models.Notification, error)
+	List(query dto.NotificationListQuery) ([]models.Notification, error)
+	FindDue(limit int) ([]models.Notification, error)
+	ExistsForBooking(event string, bookingID uint) (bool, error)
+	Claim(id uint, until time.Time) (bool, error)
+	Update(notification *models.Notification) error
+}
+
+type gormNotificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) NotificationRepository {
+	return &gormNotificationRepository{
+		db: db,
+	}
+}
+
+func (r *gormNotificationRepository) Create(notification *models.Notification) error {
+	if err := r.db.Create(notification).Error; err != nil {
+		config.GetLogger().Error("Failed to create notification", "error", err, "event", notification.Event)
+		return err
+	}
+
+	return nil
+}
+
+func (r *gormNotificationRepository) GetByID(id uint) (*models.Notification, error) {
+	var notification models.Notification
+
+	if err := r.db.First(&notification, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, constants.ErrNotificationNotFound
+		}
+		config.GetLogger().Error("Failed to get notification by id", "error", err, "notification_id", id)
+		return nil, err
+	}
+
+	return &notification, nil
+}
+
+func (r *gormNotificationRepository) List(query dto.NotificationListQuery) ([]models.Notification, error) {
+	var notifications []models.Notification
+
+	db := r.db.Order("created_at DESC")
+	if query.Status != "" {
+		db = db.Where("status = ?", query.Status)
+	}
+	if query.Event != "" {
+		db = db.Where("event = ?", query.Event)
+	}
+
+	if err := db.Find(&notifications).Error; err != nil {
+		config.GetLogger().Error("Failed to list notifications", "error", err)
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (r *gormNotificationRepository) FindDue(limit int) ([]models.Notification, error) {
+	var notifications []models.Notification
+
+	err := r.db.
+		Where("status = ? AND next_attempt_at <= ?", constants.NotificationPending, time.Now()).
+		Order("next_attempt_at").
+		Limit(limit).
+		Find(&notifications).Error
+
+	if err != nil {
+		config.GetLogger().Error("Failed to find due notifications", "error", err)
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (r *gormNotificationRepository) ExistsForBooking(event string, bookingID uint) (bool, error) {
+	var count int64
+
+	err := r.db.Model(&models.Notification{}).
+		Where("event = ? AND booking_id = ?", event, bookingID).
+		Count(&count).Error
+
+	if err != nil {
+		config.GetLogger().Error("Failed to check notification for booking", "error", err, "event", event, "booking_id", bookingID)
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Claim takes a due pending notification for delivery by moving its next
+// attempt to until. Only one caller can claim it; if the claimer dies before
+// saving the result, the notification becomes due again once until passes.
+func (r *gormNotificationRepository) Claim(id uint, until time.Time) (bool, error) {
+	result := r.db.Model(&models.Notification{}).
+		Where("id = ? AND status = ? AND next_attempt_at <= ?", id, constants.NotificationPending, time.Now()).
+		Update("next_attempt_at", until)
+
+	if result.Error != nil {
+		config.GetLogger().Error("Failed to claim notification", "error", result.Error, "notification_id", id)
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func (r *gormNotificationRepository) Update(notification *models.Notification) error {
+	if err := r.db.Save(notification).Error; err != nil {
+		config.GetLogger().Error("Failed to update notification", "error", err, "notification_id", notification.ID)
+		return err
+	}
+
+	return nil
+}
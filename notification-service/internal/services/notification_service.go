@@ -0,0 +1,250 @@
+package services
+
+import (
+	"errors"
+	"notification-service/internal/clients"
+	"notification-service/internal/config"
+	"notification-service/internal/constants"
+	"notification-service/internal/dto"
+	"notification-service/internal/mailer"
+	"notification-service/internal/models"
+	"notification-service/internal/repository"
+	"notification-service/internal/templates"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type NotificationService interface {
+	HandleBookingEvent(event dto.BookingEvent) error
+	HandleUserCreated(event dto.UserCreatedEvent) error
+	HandleSessionStatusChanged(event dto.SessionStatusEvent) error
+	DeliverDue() error
+
+	List(query dto.NotificationListQuery) ([]models.Notification, error)
+	GetByID(id uint) (*models.Notification, error)
+	Retry(id uint) (*models.Notification, error)
+}
+
+type notificationService struct {
+	notificationRepo repository.NotificationRepository
+	contactRepo      repository.ContactRepository
+	mailer           mailer.Mailer
+	maxAttempts      int
+}
+
+func NewNotificationService(notificationRepo repository.NotificationRepository, contactRepo repository.ContactRepository, mailer mailer.Mailer) NotificationService {
+	return &notificationService{
+		notificationRepo: notificationRepo,
+		contactRepo:      contactRepo,
+		mailer:           mailer,
+		maxAttempts:      getMaxAttempts(),
+	}
+}
+
+func getMaxAttempts() int {
+	attempts, err := strconv.Atoi(os.Getenv("NOTIFICATION_MAX_ATTEMPTS"))
+	if err != nil || attempts <= 0 {
+		return constants.DefaultMaxAttempts
+	}
+	return attempts
+}
+
+func (s *notificationService) HandleBookingEvent(event dto.BookingEvent) error {
+	subject, body, err := templates.RenderBookingEvent(event)
+	if err != nil {
+		if errors.Is(err, constants.ErrTemplateNotFound) {
+			config.GetLogger().Debug("No template for booking event, skipping", "event", event.Event, "booking_id", event.BookingID)
+			return nil
+		}
+		// A template that fails to render will fail again on every retry.
+		config.GetLogger().Error("Failed to render booking event, skipping", "error", err, "event", event.Event, "booking_id", event.BookingID)
+		return nil
+	}
+
+	notification := models.Notification{
+		Event:         event.Event,
+		BookingID:     event.BookingID,
+		UserID:        event.UserID,
+		Subject:       subject,
+		Body:          body,
+		Status:        constants.NotificationPending,
+		NextAttemptAt: time.Now(),
+	}
+
+	recipient, err := s.resolveRecipient(event)
+	if err != nil {
+		notification.Status = constants.NotificationFailed
+		notification.LastError = err.Error()
+		config.GetLogger().Warn("Cannot resolve notification recipient", "error", err, "booking_id", event.BookingID, "user_id", event.UserID)
+		return s.notificationRepo.Create(&notification)
+	}
+	notification.Recipient = recipient
+
+	if err := s.notificationRepo.Create(&notification); err != nil {
+		return err
+	}
+
+	s.deliver(&notification)
+
+	return nil
+}
+
+func (s *notificationService) HandleUserCreated(event dto.UserCreatedEvent) error {
+	if event.ID == 0 || event.Email == "" {
+		config.GetLogger().Warn("Skipping incomplete user.created event", "user_id", event.ID)
+		return nil
+	}
+
+	return s.contactRepo.Upsert(&models.Contact{
+		UserID: event.ID,
+		Email:  strings.ToLower(strings.TrimSpace(event.Email)),
+	})
+}
+
+// HandleSessionStatusChanged emails everyone holding a booking for a session
+// that was cancelled. Bookings that were already notified are skipped, so the
+// event can be handled again after a partial failure.
+func (s *notificationService) HandleSessionStatusChanged(event dto.SessionStatusEvent) error {
+	if event.Event != constants.EventSessionStatusChanged || event.NewStatus != constants.SessionStatusCancelled {
+		return nil
+	}
+
+	bookings, err := clients.ListActiveBookings(event.SessionID)
+	if err != nil {
+		config.GetLogger().Error("Failed to list bookings of cancelled session", "error", err, "session_id", event.SessionID)
+		return err
+	}
+
+	var failed []error
+	for _, booking := range bookings {
+		notified, err := s.notificationRepo.ExistsForBooking(constants.EventSessionCancelled, booking.ID)
+		if err != nil {
+			failed = append(failed, err)
+			continue
+		}
+		if notified {
+			continue
+		}
+
+		err = s.HandleBookingEvent(dto.BookingEvent{
+			Event:            constants.EventSessionCancelled,
+			BookingID:        booking.ID,
+			SessionID:        event.SessionID,
+			UserID:           booking.UserID,
+			GuestEmail:       booking.GuestEmail,
+			SeatsCount:       booking.SeatsCount,
+			SessionStartTime: event.StartTime,
+		})
+		if err != nil {
+			failed = append(failed, err)
+		}
+	}
+
+	return errors.Join(failed...)
+}
+
+func (s *notificationService) DeliverDue() error {
+	due, err := s.notificationRepo.FindDue(constants.DeliveryBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for i := range due {
+		s.deliver(&due[i])
+	}
+
+	return nil
+}
+
+func (s *notificationService) List(query dto.NotificationListQuery) ([]models.Notification, error) {
+	return s.notificationRepo.List(query)
+}
+
+func (s *notificationService) GetByID(id uint) (*models.Notification, error) {
+	return s.notificationRepo.GetByID(id)
+}
+
+func (s *notificationService) Retry(id uint) (*models.Notification, error) {
+	notification, err := s.notificationRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if notification.Status == constants.NotificationSent {
+		return notification, nil
+	}
+
+	if notification.Recipient == "" {
+		contact, err := s.contactRepo.GetByUserID(notification.UserID)
+		if err != nil {
+			return nil, err
+		}
+		notification.Recipient = contact.Email
+	}
+
+	notification.Status = constants.NotificationPending
+	notification.Attempts = 0
+	notification.NextAttemptAt = time.Now()
+
+	if err := s.notificationRepo.Update(notification); err != nil {
+		return nil, err
+	}
+
+	s.deliver(notification)
+
+	return notification, nil
+}
+
+func (s *notificationService) resolveRecipient(event dto.BookingEvent) (string, error) {
+	if event.GuestEmail != "" {
+		return event.GuestEmail, nil
+	}
+
+	contact, err := s.contactRepo.GetByUserID(event.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	return contact.Email, nil
+}
+
+// deliver sends a notification once it has been claimed, so the consumer and
+// the delivery worker never email the same notification twice.
+func (s *notificationService) deliver(notification *models.Notification) {
+	claimed, err := s.notificationRepo.Claim(notification.ID, time.Now().Add(constants.ClaimLeaseSeconds*time.Second))
+	if err != nil || !claimed {
+		return
+	}
+
+	notification.Attempts++
+
+	if err := s.mailer.Send(notification.Recipient, notification.Subject, notification.Body); err != nil {
+		notification.LastError = err.Error()
+		if notification.Attempts >= s.maxAttempts {
+			notification.Status = constants.NotificationFailed
+		} else {
+			backoff := time.Duration(constants.RetryBackoffSeconds<<(notification.Attempts-1)) * time.Second
+			notification.NextAttemptAt = time.Now().Add(backoff)
+		}
+		config.GetLogger().Warn("Failed to send notification",
+			"error", err,
+			"notification_id", notification.ID,
+			"attempts", notification.Attempts,
+			"status", notification.Status)
+	} else {
+		now := time.Now()
+		notification.Status = constants.NotificationSent
+		notification.SentAt = &now
+		notification.LastError = ""
+		config.GetLogger().Info("Notification sent",
+			"notification_id", notification.ID,
+			"event", notification.Event,
+			"booking_id", notification.BookingID)
+	}
+
+	if err := s.notificationRepo.Update(notification); err != nil {
+		config.GetLogger().Error("Failed to persist notification delivery status", "error", err, "notification_id", notification.ID)
+	}
+}
@@ -0,0 +1,105 @@
+package templates
+
+import (
+	"bytes"
+	"math"
+	"notification-service/internal/constants"
+	"notification-service/internal/dto"
+	"text/template"
+	"time"
+)
+
+type emailTemplate struct {
+	subject *template.Template
+	body    *template.Template
+}
+
+var funcs = template.FuncMap{
+	"datetime": func(v time.Time) string {
+		return v.Format("02.01.2006 15:04")
+	},
+	"minutesUntil": func(v time.Time) int {
+		minutes := int(math.Ceil(time.Until(v).Minutes()))
+		if minutes < 0 {
+			return 0
+		}
+		return minutes
+	},
+}
+
+func mustParse(name, subject, body string) emailTemplate {
+	return emailTemplate{
+		subject: template.Must(template.New(name + ".subject").Funcs(funcs).Parse(subject)),
+		body:    template.Must(template.New(name + ".body").Funcs(funcs).Parse(body)),
+	}
+}
+
+var bookingTemplates = map[string]emailTemplate{
+	"booking.confirmed": mustParse("confirmation",
+		"Booking #{{.BookingID}} confirmed",
+		`Hello!
+
+Your booking #{{.BookingID}} is confirmed.
+
+Session: #{{.SessionID}}
+Starts at: {{datetime .SessionStartTime}}
+Seats: {{.SeatsCount}}
+Total: {{.TotalPrice}}
+
+See you at the cinema!
+`),
+	"booking.hold_expiring": mustParse("expiry_warning",
+		"Your booking #{{.BookingID}} expires in {{minutesUntil .ExpiresAt}} minutes",
+		`Hello!
+
+Your seats for session #{{.SessionID}} are on hold for {{minutesUntil .ExpiresAt}} more minutes (until {{datetime .ExpiresAt}}).
+Please complete the payment before then, otherwise booking #{{.BookingID}} will be released.
+`),
+	"booking.expired": mustParse("expired",
+		"Booking #{{.BookingID}} expired",
+		`Hello!
+
+Booking #{{.BookingID}} for session #{{.SessionID}} was not paid in time and has expired.
+The seats have been released.
+`),
+	"booking.reminder": mustParse("session_reminder",
+		"Reminder: your session starts at {{datetime .SessionStartTime}}",
+		`Hello!
+
+This is a reminder that session #{{.SessionID}} for booking #{{.BookingID}} starts at {{datetime .SessionStartTime}}.
+Seats: {{.SeatsCount}}
+
+Enjoy the movie!
+`),
+	"booking.cancelled": mustParse("cancellation",
+		"Booking #{{.BookingID}} cancelled",
+		`Hello!
+
+Booking #{{.BookingID}} for session #{{.SessionID}} ({{datetime .SessionStartTime}}) has been cancelled.
+`),
+	"session.cancelled": mustParse("session_cancelled",
+		"Session on {{datetime .SessionStartTime}} cancelled",
+		`Hello!
+
+Unfortunately session #{{.SessionID}} on {{datetime .SessionStartTime}} has been cancelled.
+Your booking #{{.BookingID}} ({{.SeatsCount}} seats) was for this session.
+`),
+}
+
+func RenderBookingEvent(event dto.BookingEvent) (string, string, error) {
+	tmpl, ok := bookingTemplates[event.Event]
+	if !ok {
+		return "", "", constants.ErrTemplateNotFound
+	}
+
+	var subject, body bytes.Buffer
+
+	if err := tmpl.subject.Execute(&subject, event); err != nil {
+		return "", "", err
+	}
+	if err := tmpl.body.Execute(&body, event); err != nil {
+		return "", "", err
+	}
+
+	return subject.String(), body.String(), nil
+}
@@ -0,0 +1,101 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+	"notification-service/internal/config"
+	"notification-service/internal/constants"
+	"notification-service/internal/dto"
+	"notification-service/internal/services"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type notificationTransport struct {
+	service services.NotificationService
+}
+
+func NewNotificationHandler(service services.NotificationService) *notificationTransport {
+	return &notificationTransport{
+		service: service,
+	}
+}
+
+func (h *notificationTransport) NotificationRoutes(ctx *gin.Engine) {
+	api := ctx.Group("/notifications")
+	{
+		api.GET("", h.List)
+		api.GET("/:id", h.GetByID)
+		api.POST("/:id/retry", h.Retry)
+	}
+}
+
+func (h *notificationTransport) List(ctx *gin.Context) {
+	var query dto.NotificationListQuery
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid query"})
+		return
+	}
+
+	list, err := h.service.List(query)
+	if err != nil {
+		config.GetLogger().Error("Failed to list notifications", "error", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, list)
+}
+
+func (h *notificationTransport) GetByID(ctx *gin.Context) {
+	id, err := parseID(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	notification, err := h.service.GetByID(id)
+	if err != nil {
+		if errors.Is(err, constants.ErrNotificationNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, notification)
+}
+
+func (h *notificationTransport) Retry(ctx *gin.Context) {
+	id, err := parseID(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	notification, err := h.service.Retry(id)
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrNotificationNotFound):
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case errors.Is(err, constants.ErrRecipientUnknown):
+			ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, notification)
+}
+
+func parseID(idStr string) (uint, error) {
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return 0, constants.ErrInvalidID
+	}
+	return uint(id), nil
+}
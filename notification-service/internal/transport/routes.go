@@ -0,0 +1,13 @@
+package transport
+
+import (
+	"notification-service/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterRoutes(router *gin.Engine, notificationService services.NotificationService) {
+	notificationHandler := NewNotificationHandler(notificationService)
+
+	notificationHandler.NotificationRoutes(router)
+}
@@ -0,0 +1,20 @@
+package workers
+
+import (
+	"notification-service/internal/config"
+	"notification-service/internal/services"
+	"time"
+)
+
+func StartDeliveryWorker(notificationService services.NotificationService) {
+	ticker := time.NewTicker(15 * time.Second)
+
+	logger := config.GetLogger()
+	logger.Info("Notification delivery worker started", "interval", "15 second")
+
+	for range ticker.C {
+		if err := notificationService.DeliverDue(); err != nil {
+			logger.Error("Failed to deliver pending notifications", "error", err)
+		}
+	}
+}
@@ -78,13 +78,22 @@ for movie in "${MOVIES[@]}"; do
 done
 echo ""
 
-# 4. Создание залов
-echo "=== 4. Создание залов ==="
+# 4. Создание кинотеатра и залов
+echo "=== 4. Создание кинотеатра и залов ==="
+RESPONSE=$(curl -s -X POST "$CINEMA_SERVICE_URL/cinemas" \
+  -H "Content-Type: application/json" \
+  -d '{"name": "Main", "address": "Центральная, 1", "timezone": "Europe/Moscow", "opening_hours": {"mon": {"open": "09:00", "close": "02:00"}, "tue": {"open": "09:00", "close": "02:00"}, "wed": {"open": "09:00", "close": "02:00"}, "thu": {"open": "09:00", "close": "02:00"}, "fri": {"open": "09:00", "close": "03:00"}, "sat": {"open": "09:00", "close": "03:00"}, "sun": {"open": "09:00", "close": "02:00"}}}')
+CINEMA_ID=$(echo "$RESPONSE" | jq -r '.id // empty')
+if [ -z "$CINEMA_ID" ] || [ "$CINEMA_ID" == "null" ]; then
+  CINEMA_ID=$(curl -s "$CINEMA_SERVICE_URL/cinemas" | jq -r '.[] | select(.name == "Main") | .id')
+fi
+echo "  ✅ Кинотеатр ID: $CINEMA_ID"
+
 HALL_IDS=()
 for hall_num in {1..3}; do
   RESPONSE=$(curl -s -X POST "$CINEMA_SERVICE_URL/halls" \
     -H "Content-Type: application/json" \
-    -d "{\"number\": $hall_num}")
+    -d "{\"cinema_id\": $CINEMA_ID, \"number\": $hall_num}")
   HALL_ID=$(echo "$RESPONSE" | jq -r '.id // empty')
   if [ -n "$HALL_ID" ] && [ "$HALL_ID" != "null" ]; then
     HALL_IDS+=($HALL_ID)
@@ -98,20 +107,16 @@ echo ""
 # 5. Создание мест
 echo "=== 5. Создание мест ==="
 for hall_id in "${HALL_IDS[@]}"; do
-  echo "  Создание мест для зала ID: $hall_id"
-  SEAT_COUNT=0
-  for row in {1..5}; do
-    for seat_num in {1..8}; do
-      RESPONSE=$(curl -s -X POST "$CINEMA_SERVICE_URL/halls/$hall_id/seats" \
-        -H "Content-Type: application/json" \
-        -d "{\"row\": $row, \"number\": $seat_num, \"type\": \"standard\"}")
-      SEAT_ID=$(echo "$RESPONSE" | jq -r '.id // empty')
-      if [ -n "$SEAT_ID" ] && [ "$SEAT_ID" != "null" ]; then
-        ((SEAT_COUNT++))
-      fi
-    done
-  done
-  echo "    ✅ Создано мест: $SEAT_COUNT"
+  echo "  Генерация схемы зала ID: $hall_id"
+  RESPONSE=$(curl -s -X PUT "$CINEMA_SERVICE_URL/halls/$hall_id/layout" \
+    -H "Content-Type: application/json" \
+    -d '{"rows": 5, "seats_per_row": 8, "row_specs": [{"row": 5, "type": "vip"}], "replace": true}')
+  SEAT_COUNT=$(echo "$RESPONSE" | jq 'if type == "array" then [.[].seats[]] | length else 0 end')
+  if [ -n "$SEAT_COUNT" ] && [ "$SEAT_COUNT" != "0" ]; then
+    echo "    ✅ Создано мест: $SEAT_COUNT"
+  else
+    echo "    ❌ Ошибка создания мест: $RESPONSE"
+  fi
 done
 echo ""
 
@@ -4,6 +4,8 @@ type RegisterRequest struct {
 	Email    string `json:"email" binding:"required,email"`
 	Password string `json:"password" binding:"required,min=6"`
 	Name     string `json:"name" binding:"required"`
+
+	Birthdate string `json:"birthdate"`
 }
 
 type LoginRequest struct {
@@ -5,17 +5,41 @@ type CreateUserRequest struct {
 	Password string `json:"password" binding:"required,min=6"`
 	Name     string `json:"name" binding:"required"`
 	Role     string `json:"role"`
+
+	Birthdate string `json:"birthdate"`
 }
 
 type UpdateUserRequest struct {
-	Email *string `json:"email"`
-	Name  *string `json:"name"`
-	Role  *string `json:"role"`
+	Email     *string `json:"email"`
+	Name      *string `json:"name"`
+	Role      *string `json:"role"`
+	Birthdate *string `json:"birthdate"`
+}
+
+// UpdateMeRequest holds the profile fields users may change themselves.
+type UpdateMeRequest struct {
+	Birthdate *string `json:"birthdate" binding:"required"`
 }
 
 type UserResponse struct {
-	ID    uint   `json:"id"`
-	Email string `json:"email"`
-	Name  string `json:"name"`
-	Role  string `json:"role"`
+	ID        uint   `json:"id"`
+	Email     string `json:"email"`
+	Name      string `json:"name"`
+	Role      string `json:"role"`
+	Birthdate string `json:"birthdate,omitempty"`
+}
+
+type UserBirthdateResponse struct {
+	ID        uint   `json:"id"`
+	Birthdate string `json:"birthdate,omitempty"`
+}
+
+type NotificationPreferences struct {
+	SessionReminders bool `json:"session_reminders"`
+	HoldWarnings     bool `json:"hold_warnings"`
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	SessionReminders *bool `json:"session_reminders"`
+	HoldWarnings     *bool `json:"hold_warnings"`
 }
@@ -4,4 +4,5 @@ import "errors"
 
 var (
 	ErrUserAlreadyExists = errors.New("user already exists")
+	ErrInvalidBirthdate  = errors.New("birthdate must be a past date in YYYY-MM-DD format")
 )
@@ -1,6 +1,10 @@
 package models
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type User struct {
 	gorm.Model
@@ -8,4 +12,9 @@ type User struct {
 	Password string `gorm:"not null" json:"-"`
 	Name     string `gorm:"not null" json:"name"`
 	Role     string `gorm:"not null;default:user" json:"role"`
+
+	Birthdate *time.Time `gorm:"type:date" json:"birthdate,omitempty"`
+
+	SessionRemindersOptOut bool `gorm:"not null;default:false" json:"session_reminders_opt_out"`
+	HoldWarningsOptOut     bool `gorm:"not null;default:false" json:"hold_warnings_opt_out"`
 }
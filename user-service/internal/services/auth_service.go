@@ -32,6 +32,11 @@ func (s *authService) Register(req dto.RegisterRequest) (*models.User, error) {
 		return nil, errors.ErrUserAlreadyExists
 	}
 
+	birthdate, err := parseBirthdate(req.Birthdate)
+	if err != nil {
+		return nil, err
+	}
+
 	hashedPassword, err := bcrypt.GenerateFromPassword(
 		[]byte(req.Password),
 		bcrypt.DefaultCost,
@@ -41,15 +46,18 @@ func (s *authService) Register(req dto.RegisterRequest) (*models.User, error) {
 	}
 
 	user := &models.User{
-		Email:    req.Email,
-		Password: string(hashedPassword),
-		Name:     req.Name,
-		Role:     "user",
+		Email:     req.Email,
+		Password:  string(hashedPassword),
+		Name:      req.Name,
+		Role:      "user",
+		Birthdate: birthdate,
 	}
 
 	if err := s.repo.Create(user); err != nil {
 		return nil, err
 	}
+	// booking-service attaches guest bookings made with this email on the
+	// user.created event.
 	if err := s.producer.SendUserCreated(kafka.UserCreatedEvent{
 		ID:    user.ID,
 		Email: user.Email,
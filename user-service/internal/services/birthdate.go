@@ -0,0 +1,21 @@
+package services
+
+import (
+	"time"
+	"user-service/internal/errors"
+)
+
+const BirthdateLayout = "2006-01-02"
+
+func parseBirthdate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	birthdate, err := time.Parse(BirthdateLayout, value)
+	if err != nil || birthdate.After(time.Now()) {
+		return nil, errors.ErrInvalidBirthdate
+	}
+
+	return &birthdate, nil
+}
@@ -17,6 +17,9 @@ type UserService interface {
 	List() ([]models.User, error)
 	Update(id uint, req dto.UpdateUserRequest) (*models.User, error)
 	Delete(id uint) error
+
+	GetNotificationPreferences(id uint) (*dto.NotificationPreferences, error)
+	UpdateNotificationPreferences(id uint, req dto.UpdateNotificationPreferencesRequest) (*dto.NotificationPreferences, error)
 }
 
 type userService struct {
@@ -29,6 +32,11 @@ func NewUserService(repo repository.UserRepository, log *slog.Logger) UserServic
 }
 
 func (s *userService) Create(req dto.CreateUserRequest) (*models.User, error) {
+	birthdate, err := parseBirthdate(req.Birthdate)
+	if err != nil {
+		return nil, err
+	}
+
 	hashedPassword, err := bcrypt.GenerateFromPassword(
 		[]byte(req.Password), bcrypt.DefaultCost,
 	)
@@ -38,10 +46,11 @@ func (s *userService) Create(req dto.CreateUserRequest) (*models.User, error) {
 	}
 
 	user := &models.User{
-		Email:    req.Email,
-		Password: string(hashedPassword),
-		Name:     req.Name,
-		Role:     req.Role,
+		Email:     req.Email,
+		Password:  string(hashedPassword),
+		Name:      req.Name,
+		Role:      req.Role,
+		Birthdate: birthdate,
 	}
 
 	if user.Role == "" {
@@ -98,6 +107,14 @@ func (s *userService) Update(id uint, req dto.UpdateUserRequest) (*models.User,
 		user.Role = *req.Role
 	}
 
+	if req.Birthdate != nil {
+		birthdate, err := parseBirthdate(*req.Birthdate)
+		if err != nil {
+			return nil, err
+		}
+		user.Birthdate = birthdate
+	}
+
 	if err := s.repo.Update(user); err != nil {
 		s.log.Error("failed to update user", "id", id, "err", err)
 		return nil, err
@@ -124,3 +141,41 @@ func (s *userService) Delete(id uint) error {
 
 	return nil
 }
+
+func (s *userService) GetNotificationPreferences(id uint) (*dto.NotificationPreferences, error) {
+	user, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toNotificationPreferences(user), nil
+}
+
+func (s *userService) UpdateNotificationPreferences(id uint, req dto.UpdateNotificationPreferencesRequest) (*dto.NotificationPreferences, error) {
+	user, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.SessionReminders != nil {
+		user.SessionRemindersOptOut = !*req.SessionReminders
+	}
+
+	if req.HoldWarnings != nil {
+		user.HoldWarningsOptOut = !*req.HoldWarnings
+	}
+
+	if err := s.repo.Update(user); err != nil {
+		s.log.Error("failed to update notification preferences", "id", id, "err", err)
+		return nil, err
+	}
+
+	return toNotificationPreferences(user), nil
+}
+
+func toNotificationPreferences(u *models.User) *dto.NotificationPreferences {
+	return &dto.NotificationPreferences{
+		SessionReminders: !u.SessionRemindersOptOut,
+		HoldWarnings:     !u.HoldWarningsOptOut,
+	}
+}
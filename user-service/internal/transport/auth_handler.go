@@ -36,6 +36,13 @@ func (h *AuthHandler) Register(c *gin.Context) {
 			return
 		}
 
+		if err == errors.ErrInvalidBirthdate {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
 		})
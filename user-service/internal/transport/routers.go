@@ -30,6 +30,7 @@ func RegisterRouters(r *gin.Engine,
 
 	{
 		user.GET("", users.List)
+		user.PATCH("/me", users.UpdateMe)
 		user.GET("/:id", users.Get)
 		user.DELETE("/:id", users.Delete)
 		user.PUT("/:id", users.Update)
@@ -41,6 +42,14 @@ func RegisterRouters(r *gin.Engine,
 	{
 		protected.GET("/me", users.Me)
 		protected.GET("/me/bookings", users.MyBookings)
+		protected.GET("/me/notification-preferences", users.MyNotificationPreferences)
+		protected.PUT("/me/notification-preferences", users.UpdateMyNotificationPreferences)
+	}
+
+	internal := r.Group("/internal")
+	{
+		internal.GET("/users/:id/notification-preferences", users.NotificationPreferences)
+		internal.GET("/users/:id/birthdate", users.Birthdate)
 	}
 
 }
@@ -6,10 +6,12 @@ import (
 	"strconv"
 	"user-service/internal/config"
 	"user-service/internal/dto"
+	"user-service/internal/errors"
 	"user-service/internal/models"
 	"user-service/internal/services"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 )
 
 type UserHandler struct {
@@ -42,6 +44,10 @@ func (h *UserHandler) Create(c *gin.Context) {
 
 	user, err := h.service.Create(req)
 	if err != nil {
+		if err == errors.ErrInvalidBirthdate {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
 		h.log.Error("failed to create user", "email", req.Email, "err", err)
 		c.JSON(500, gin.H{"error": "internal error"})
 		return
@@ -97,6 +103,10 @@ func (h *UserHandler) Update(c *gin.Context) {
 
 	user, err := h.service.Update(uint(id), req)
 	if err != nil {
+		if err == errors.ErrInvalidBirthdate {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
 		h.log.Warn("user not found for update", "id", id)
 		c.JSON(404, gin.H{"error": "not found"})
 		return
@@ -122,13 +132,21 @@ func (h *UserHandler) Delete(c *gin.Context) {
 
 func toUserResponse(u *models.User) dto.UserResponse {
 	return dto.UserResponse{
-		ID:    u.ID,
-		Email: u.Email,
-		Name:  u.Name,
-		Role:  u.Role,
+		ID:        u.ID,
+		Email:     u.Email,
+		Name:      u.Name,
+		Role:      u.Role,
+		Birthdate: formatBirthdate(u),
 	}
 }
 
+func formatBirthdate(u *models.User) string {
+	if u.Birthdate == nil {
+		return ""
+	}
+	return u.Birthdate.Format(services.BirthdateLayout)
+}
+
 func (h *UserHandler) Me(c *gin.Context) {
 	userID := c.GetUint("user_id")
 
@@ -142,6 +160,30 @@ func (h *UserHandler) Me(c *gin.Context) {
 	c.JSON(200, toUserResponse(user))
 }
 
+func (h *UserHandler) UpdateMe(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	var req dto.UpdateMeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Warn("invalid update me request", "user_id", userID, "err", err)
+		c.JSON(400, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	user, err := h.service.Update(userID, dto.UpdateUserRequest{Birthdate: req.Birthdate})
+	if err != nil {
+		if err == errors.ErrInvalidBirthdate {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		h.log.Warn("me: user not found for update", "user_id", userID)
+		c.JSON(404, gin.H{"error": "user not found"})
+		return
+	}
+
+	c.JSON(200, toUserResponse(user))
+}
+
 func (h *UserHandler) MyBookings(c *gin.Context) {
 	userID := c.GetUint("user_id")
 
@@ -163,3 +205,79 @@ func (h *UserHandler) MyBookings(c *gin.Context) {
 		nil,
 	)
 }
+
+func (h *UserHandler) MyNotificationPreferences(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	prefs, err := h.service.GetNotificationPreferences(userID)
+	if err != nil {
+		h.log.Warn("preferences: user not found", "user_id", userID)
+		c.JSON(404, gin.H{"error": "user not found"})
+		return
+	}
+
+	c.JSON(200, prefs)
+}
+
+func (h *UserHandler) UpdateMyNotificationPreferences(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	var req dto.UpdateNotificationPreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Warn("invalid notification preferences request", "user_id", userID, "err", err)
+		c.JSON(400, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	prefs, err := h.service.UpdateNotificationPreferences(userID, req)
+	if err != nil {
+		h.log.Warn("preferences: failed to update", "user_id", userID, "err", err)
+		c.JSON(404, gin.H{"error": "user not found"})
+		return
+	}
+
+	c.JSON(200, prefs)
+}
+
+func (h *UserHandler) NotificationPreferences(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		h.log.Warn("invalid user id", "id", c.Param("id"))
+		c.JSON(400, gin.H{"error": "invalid user id"})
+		return
+	}
+
+	prefs, err := h.service.GetNotificationPreferences(uint(id))
+	if err == gorm.ErrRecordNotFound {
+		h.log.Warn("preferences: user not found", "id", id)
+		c.JSON(404, gin.H{"error": "not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(500, gin.H{"error": "failed to get notification preferences"})
+		return
+	}
+
+	c.JSON(200, prefs)
+}
+
+func (h *UserHandler) Birthdate(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		h.log.Warn("invalid user id", "id", c.Param("id"))
+		c.JSON(400, gin.H{"error": "invalid user id"})
+		return
+	}
+
+	user, err := h.service.Get(uint(id))
+	if err != nil {
+		h.log.Warn("birthdate: user not found", "id", id)
+		c.JSON(404, gin.H{"error": "not found"})
+		return
+	}
+
+	c.JSON(200, dto.UserBirthdateResponse{
+		ID:        user.ID,
+		Birthdate: formatBirthdate(user),
+	})
+}